@@ -1,23 +1,34 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 
 	rocketApi "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/api/rocket"
+	tenantApi "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/api/tenant"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/gateway"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/validator"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/health"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
 	rocketService "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service/rocket"
+	tenantService "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service/tenant"
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	tenantpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/tenant/v1"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
 	grpc_zap "github.com/grpc-ecosystem/go-grpc-middleware/logging/zap"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 )
@@ -26,7 +37,26 @@ var (
 	port           = flag.Int("port", 10000, "The server port")
 	devel          = flag.Bool("devel", false, "Set the api-server to development mode (nice log, grpcui etc.)")
 	oauthClientID  = flag.String("oauth-client-id", "kubernetes", "oauth Client ID of the issuer")
-	oauthIssuerUrl = flag.String("oauth-issuer-url", "https://keycloak:8443/auth/realms/kubernetes", "oauth Client ID of the issuer")
+	oauthIssuerUrl = flag.String("oauth-issuer-url", "https://keycloak:8443/auth/realms/kubernetes", "URL of the oauth issuer used to verify tokens")
+	rocketVersion  = flag.String("default-rocket-version", "3.18.2", "Rocket.Chat version used when a create request doesn't specify one")
+	mongodbVersion = flag.String("default-mongodb-version", "4.4.10", "MongoDB version used when a create request doesn't specify one")
+	clusterAccess  = flag.String("cluster-access", "token", "How requests access the cluster: token forwards the user token, impersonation uses the api-server credentials and impersonates the user")
+	usernameClaim  = flag.String("impersonation-username-claim", "preferred_username", "Claim used as kubernetes username in impersonation mode and in the role bindings of tenants (sub, email or preferred_username)")
+	usernamePrefix = flag.String("impersonation-username-prefix", "", "Prefix added to the impersonated username and the username in the role bindings of tenants, should match the --oidc-username-prefix of the cluster. Required with -cluster-access=impersonation")
+	groupsPrefix   = flag.String("impersonation-groups-prefix", "", "Prefix added to the impersonated groups, should match the --oidc-groups-prefix of the cluster. Required with -cluster-access=impersonation")
+	cacheSize      = flag.Int("client-cache-size", 256, "Maximum amount of users whose kubernetes clients are cached")
+	readCache      = flag.Bool("read-cache", true, "Serve reads of rockets from a cache filled with the api-server credentials, after an access review of the user")
+	reviewTTL      = flag.Duration("access-review-ttl", k8sutil.DefaultAccessReviewTTL, "Time the access reviews of users are cached when serving reads from the cache")
+	tenantRole     = flag.String("tenant-cluster-role", tenantService.DefaultClusterRole, "Cluster role bound to users in the namespace of their tenant, needs to grant the rights on rockets")
+	tenantCPU      = flag.String("tenant-max-cpu", "4", "Maximum cpu quota users can register for their tenant")
+	tenantMemory   = flag.String("tenant-max-memory", "8Gi", "Maximum memory quota users can register for their tenant")
+	tenantStorage  = flag.String("tenant-max-storage", "50Gi", "Maximum storage quota users can register for their tenant")
+	issuerMode     = flag.String("issuer-mode", string(rocketService.IssuerModeACME), "Kind of the cert-manager issuers created for users without an issuer: acme, selfsigned or ca")
+	acmeServer     = flag.String("acme-server", rocketService.LetsEncryptServer, "Directory url of the ACME server of acme issuers")
+	acmeClass      = flag.String("acme-ingress-class", "", "Class of the ingress solving the HTTP-01 challenges of acme issuers, defaults to the ingress class of the rocket")
+	ingressClass   = flag.String("default-ingress-class", "", "Ingress class used when a create request doesn't specify one, defaults to the default ingress class of the cluster")
+	allowedDomains = flag.String("allowed-domains", "", "Comma separated domains allowed for the hosts of rockets with their subdomains, all domains are allowed if empty. The chat.accso.de/allowed-domains annotation of a namespace replaces them")
+	issuerCASecret = flag.String("issuer-ca-secret", "", "Name of the secret of the CA key pair of ca issuers, needs to exist in the namespaces of the rockets")
 	logger         *zap.Logger
 )
 
@@ -40,14 +70,23 @@ func main() {
 		logger, _ = zap.NewProduction()
 	}
 
+	authenticator, err := oauth.NewAuthenticator(context.Background(), *oauthIssuerUrl, *oauthClientID)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Failed to create oauth authenticator: %v", err))
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc_middleware.WithUnaryServerChain(
 			grpc_zap.UnaryServerInterceptor(logger),
-			grpc_auth.UnaryServerInterceptor(oauth.Middleware),
+			grpc_auth.UnaryServerInterceptor(authenticator.Middleware),
+			validator.UnaryServerInterceptor(),
+			apierror.UnaryServerInterceptor(),
 		),
 		grpc_middleware.WithStreamServerChain(
-			grpc_auth.StreamServerInterceptor(oauth.Middleware),
+			grpc_auth.StreamServerInterceptor(authenticator.Middleware),
 			grpc_zap.StreamServerInterceptor(logger),
+			validator.StreamServerInterceptor(),
+			apierror.StreamServerInterceptor(),
 		),
 	)
 
@@ -59,10 +98,6 @@ func main() {
 	if err != nil {
 		logger.Fatal(fmt.Sprintf("Failed to get kubernetes client from config: %v", err))
 	}
-	chatclient, err := k8sutil.NewChatClientsetFromKubeconfig()
-	if err != nil {
-		logger.Fatal(fmt.Sprintf("Failed to get chat kubeclient from config: %v", err))
-	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf("0.0.0.0:%v", *port))
 	if err != nil {
@@ -71,11 +106,89 @@ func main() {
 
 	healthService := health.NewHealthChecker(kubeclient)
 
+	var clientFactory k8sutil.ClientFactory
+	clientsetCache := k8sutil.NewClientsetCache(*cacheSize)
+	switch *clusterAccess {
+	case "token":
+		clientFactory, err = k8sutil.NewTokenClientFactory(clientsetCache)
+	case "impersonation":
+		clientFactory, err = k8sutil.NewImpersonationClientFactory(clientsetCache, k8sutil.ImpersonationOptions{
+			UsernameClaim:  *usernameClaim,
+			UsernamePrefix: *usernamePrefix,
+			GroupsPrefix:   *groupsPrefix,
+		})
+	default:
+		err = fmt.Errorf("unknown cluster access mode %v", *clusterAccess)
+	}
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Failed to create kubernetes client factory: %v", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	chatclient, err := k8sutil.NewChatClientsetFromKubeconfig()
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Failed to get chat client from config: %v", err))
+	}
+	authorizer := k8sutil.NewAccessReviewAuthorizer(clientFactory, *reviewTTL)
+
+	issuerOpts := rocketService.IssuerOptions{
+		Mode:         rocketService.IssuerMode(*issuerMode),
+		ACMEServer:   *acmeServer,
+		IngressClass: *acmeClass,
+		CASecret:     *issuerCASecret,
+	}
+	if err := issuerOpts.Validate(); err != nil {
+		logger.Fatal(fmt.Sprintf("Invalid issuer options: %v", err))
+	}
+	rocketOpts := []rocketService.Option{
+		rocketService.WithDefaultVersions(*rocketVersion, *mongodbVersion),
+		rocketService.WithIssuer(issuerOpts),
+		rocketService.WithDefaultIngressClass(*ingressClass),
+	}
+	// the host checker looks up the hosts of rockets and ingresses in the caches
+	rocketCache := k8sutil.NewRocketCache(chatclient, 0)
+	logger.Info("Syncing rocket cache ...")
+	if err := rocketCache.Run(ctx); err != nil {
+		logger.Fatal(fmt.Sprintf("Failed to start rocket cache: %v", err))
+	}
+	ingressCache := k8sutil.NewIngressCache(kubeclient, 0)
+	logger.Info("Syncing ingress cache ...")
+	if err := ingressCache.Run(ctx); err != nil {
+		logger.Fatal(fmt.Sprintf("Failed to start ingress cache: %v", err))
+	}
+	if *readCache {
+		rocketOpts = append(rocketOpts, rocketService.WithCache(rocketCache, authorizer))
+	}
+	rocketOpts = append(rocketOpts, rocketService.WithHostChecker(
+		rocketService.NewHostChecker(kubeclient, rocketCache, ingressCache, strings.Split(*allowedDomains, ","))))
+
 	// rocket proto Service
-	rocketService := rocketService.NewRocketServiceImpl(kubeclient, chatclient)
+	rocketService := rocketService.NewRocketServiceImpl(clientFactory, rocketOpts...)
 	rocketAPI := rocketApi.NewAPIServer(rocketService)
 	rocketpb.RegisterRocketServiceServer(grpcServer, rocketAPI)
 
+	// tenant proto Service
+	maxCPU, err := resource.ParseQuantity(*tenantCPU)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Invalid maximum cpu quota of tenants: %v", err))
+	}
+	maxMemory, err := resource.ParseQuantity(*tenantMemory)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Invalid maximum memory quota of tenants: %v", err))
+	}
+	maxStorage, err := resource.ParseQuantity(*tenantStorage)
+	if err != nil {
+		logger.Fatal(fmt.Sprintf("Invalid maximum storage quota of tenants: %v", err))
+	}
+	tenantService := tenantService.NewTenantServiceImpl(kubeclient, chatclient, authorizer,
+		tenantService.WithClusterRole(*tenantRole),
+		tenantService.WithUsername(*usernameClaim, *usernamePrefix),
+		tenantService.WithMaxQuota(maxCPU, maxMemory, maxStorage),
+	)
+	tenantpb.RegisterTenantServiceServer(grpcServer, tenantApi.NewAPIServer(tenantService))
+
 	grpc_health_v1.RegisterHealthServer(grpcServer, healthService)
 
 	if *devel {
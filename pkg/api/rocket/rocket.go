@@ -6,7 +6,9 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
 
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/validator"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
@@ -23,105 +25,168 @@ func NewAPIServer(service service.RocketService) *rocketAPIServer {
 }
 
 func (r *rocketAPIServer) Create(ctx context.Context, req *rocketpb.CreateRequest) (*rocketpb.CreateResponse, error) {
-	err := r.service.Create(ctx, req.GetHost(), req.GetName(), req.GetNamespace(), req.GetEmail(), req.GetUser(), req.GetDatabaseSize(), req.GetReplicas())
+	rocket, err := r.service.Create(ctx, req.GetHost(), req.GetName(), req.GetNamespace(), req.GetEmail(), req.GetUser(), req.GetRocketVersion(), req.GetMongodbVersion(), req.GetIngressClass(), req.GetDatabaseSize(), req.GetReplicas(), req.GetDryRun())
 	if err != nil {
 		return nil, err
 	}
-	return &rocketpb.CreateResponse{}, nil
+	return &rocketpb.CreateResponse{Rocket: k8sutil.RocketToResponse(rocket)}, nil
 }
 
 func (r *rocketAPIServer) AvailableVersions(ctx context.Context, req *rocketpb.AvailableVersionsRequest) (*rocketpb.AvailableVersionsResponse, error) {
 	var repo string
 	switch i := req.Image; i {
 	case rocketpb.AvailableVersionsRequest_IMAGE_MONGODB:
-		repo = "bitnami/mongodb"
+		repo = service.MongodbRepository
 	case rocketpb.AvailableVersionsRequest_IMAGE_ROCKETCHAT:
-		repo = "rocketchat/rocket.chat"
+		repo = service.RocketRepository
 	case rocketpb.AvailableVersionsRequest_IMAGE_UNSPECIFIED:
 		return &rocketpb.AvailableVersionsResponse{}, status.Error(codes.InvalidArgument, "Image doesnt match")
 	default:
 		return &rocketpb.AvailableVersionsResponse{}, status.Error(codes.InvalidArgument, "Image can't be empty")
 	}
-	tags, err := r.service.AvailableVersions(repo)
+	tags, err := r.service.AvailableVersions(ctx, repo)
 	return &rocketpb.AvailableVersionsResponse{Tags: tags}, err
 
 }
 
 func (r *rocketAPIServer) Status(req *rocketpb.StatusRequest, stream rocketpb.RocketService_StatusServer) error {
-	if req.GetNamespace() == "" {
-		return status.Error(codes.InvalidArgument, "Namespace can't be empty")
-	}
 	return r.service.Status(req.GetName(), req.GetNamespace(), stream)
 }
 
-func (r *rocketAPIServer) Update(_ context.Context, req *rocketpb.UpdateRequest) (*rocketpb.UpdateResponse, error) {
-	panic("not implemented") // TODO: Implement
+func (r *rocketAPIServer) WatchRockets(req *rocketpb.WatchRocketsRequest, stream rocketpb.RocketService_WatchRocketsServer) error {
+	return r.service.WatchRockets(req, stream)
 }
 
-func (r *rocketAPIServer) Delete(ctx context.Context, req *rocketpb.DeleteRequest) (*rocketpb.DeleteResponse, error) {
-
-	if req.GetNamespace() == "" {
-		return &rocketpb.DeleteResponse{}, status.Error(codes.InvalidArgument, "Namespace can't be empty")
+func (r *rocketAPIServer) Update(ctx context.Context, req *rocketpb.UpdateRequest) (*rocketpb.UpdateResponse, error) {
+	// the rules of the updated rocket only apply to the identifying fields and the fields that get updated
+	fields := []string{"name", "namespace"}
+	if mask := req.GetUpdateMask(); mask != nil {
+		fields = append(fields, mask.GetPaths()...)
+	} else {
+		req.GetUpdatedRocket().ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			fields = append(fields, string(fd.Name()))
+			return true
+		})
+	}
+	err := validator.ValidateFields(req.GetUpdatedRocket(), "updated_rocket.", fields)
+	if err != nil {
+		return nil, err
+	}
 
+	rocket, err := r.service.Update(ctx, req)
+	if err != nil {
+		return nil, err
 	}
-	err := r.service.Delete(ctx, req.GetName(), req.GetNamespace())
+	return &rocketpb.UpdateResponse{Successful: true, Rocket: k8sutil.RocketToResponse(rocket)}, nil
+}
+
+func (r *rocketAPIServer) Delete(ctx context.Context, req *rocketpb.DeleteRequest) (*rocketpb.DeleteResponse, error) {
+	rocket, claims, err := r.service.Delete(ctx, req.GetName(), req.GetNamespace(), req.GetDryRun())
 	if err != nil {
-		return &rocketpb.DeleteResponse{}, status.Error(codes.Internal, err.Error())
+		return &rocketpb.DeleteResponse{}, err
 	}
-	return &rocketpb.DeleteResponse{}, nil
+	return &rocketpb.DeleteResponse{Rocket: k8sutil.RocketToResponse(rocket), VolumeClaims: claims}, nil
 }
 
 func (r *rocketAPIServer) Get(ctx context.Context, req *rocketpb.GetRequest) (*rocketpb.GetResponse, error) {
 	rocket, err := r.service.Get(ctx, req.GetName(), req.GetNamespace())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
-	resp := &rocketpb.GetResponse{
-		Status:           rocket.Status.Message,
-		Phase:            string(rocket.Status.Phase),
-		WebserverVersion: rocket.Spec.Version,
-		MongodbVersion:   rocket.Spec.Database.Version,
-		Pods:             k8sutil.GetPodNamesFromRocket(rocket),
-		Name:             rocket.Name,
-		Namespace:        rocket.Namespace,
+	return k8sutil.RocketToResponse(rocket), nil
+}
+
+func (r *rocketAPIServer) GetAll(ctx context.Context, req *rocketpb.GetAllRequest) (*rocketpb.GetAllResponse, error) {
+	page, err := r.service.GetAll(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
-	// get databasesize if exists
-	storageSpec := rocket.Spec.Database.StorageSpec
-	if storageSpec != nil {
-		resp.DatabaseSize = storageSpec.Status.Capacity.Storage().String()
+	resp := &rocketpb.GetAllResponse{
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}
+	for i := range page.Rockets {
+		resp.Rockets = append(resp.Rockets, k8sutil.RocketToResponse(&page.Rockets[i]))
 	}
 	return resp, nil
 }
 
-func (r *rocketAPIServer) GetAll(ctx context.Context, req *rocketpb.GetAllRequest) (*rocketpb.GetAllResponse, error) {
-	resp := &rocketpb.GetAllResponse{}
-	rocketList, err := r.service.GetAll(ctx, req.Namespace)
+func (r *rocketAPIServer) Logs(req *rocketpb.LogsRequest, stream rocketpb.RocketService_LogsServer) error {
+	return r.service.Logs(req, stream)
+}
+
+func (r *rocketAPIServer) LogsArchive(ctx context.Context, req *rocketpb.LogsArchiveRequest) (*rocketpb.LogsArchiveResponse, error) {
+	archive, err := r.service.LogsArchive(ctx, req)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	for _, rocket := range rocketList.Items {
-		resp.Rockets = append(resp.Rockets, &rocketpb.GetResponse{
-			Status:           rocket.Status.Message,
-			Phase:            string(rocket.Status.Phase),
-			WebserverVersion: rocket.Spec.Version,
-			MongodbVersion:   rocket.Spec.Database.Version,
-			Pods:             k8sutil.GetPodNamesFromRocket(&rocket),
-			Name:             rocket.Name,
-			Namespace:        rocket.Namespace,
-		})
+		return nil, err
+	}
+	return &rocketpb.LogsArchiveResponse{
+		Archive:  archive,
+		Filename: fmt.Sprintf("%v-%v-logs.tar.gz", req.GetNamespace(), req.GetName()),
+	}, nil
+}
+
+func (r *rocketAPIServer) Events(ctx context.Context, req *rocketpb.EventsRequest) (*rocketpb.EventsResponse, error) {
+	events, err := r.service.Events(ctx, req.GetName(), req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	resp := &rocketpb.EventsResponse{}
+	for i := range events {
+		resp.Events = append(resp.Events, k8sutil.EventToResponse(&events[i]))
 	}
 	return resp, nil
 }
 
-func (r *rocketAPIServer) Logs(req *rocketpb.LogsRequest, stream rocketpb.RocketService_LogsServer) error {
-	if req.GetNamespace() == "" {
-		return fmt.Errorf("Need to specify the namespace")
+func (r *rocketAPIServer) WatchEvents(req *rocketpb.WatchEventsRequest, stream rocketpb.RocketService_WatchEventsServer) error {
+	return r.service.WatchEvents(req, stream)
+}
+
+func (r *rocketAPIServer) GetIssuer(ctx context.Context, req *rocketpb.GetIssuerRequest) (*rocketpb.Issuer, error) {
+	issuer, err := r.service.GetIssuer(ctx, req.GetNamespace(), req.GetUser())
+	if err != nil {
+		return nil, err
+	}
+	return k8sutil.IssuerToResponse(issuer), nil
+}
+
+func (r *rocketAPIServer) UpdateIssuer(ctx context.Context, req *rocketpb.UpdateIssuerRequest) (*rocketpb.Issuer, error) {
+	issuer, err := r.service.UpdateIssuer(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return k8sutil.IssuerToResponse(issuer), nil
+}
+
+func (r *rocketAPIServer) Certificate(ctx context.Context, req *rocketpb.CertificateRequest) (*rocketpb.CertificateResponse, error) {
+	certificate, err := r.service.Certificate(ctx, req.GetName(), req.GetNamespace())
+	if err != nil {
+		return nil, err
 	}
-	err := r.service.Logs(req.Name, req.Namespace, req.Pod, stream)
+	return k8sutil.CertificateToResponse(&certificate.Certificate, certificate.FailureReason), nil
+}
+
+func (r *rocketAPIServer) ListIngressClasses(ctx context.Context, _ *rocketpb.ListIngressClassesRequest) (*rocketpb.ListIngressClassesResponse, error) {
+	classes, err := r.service.IngressClasses(ctx)
 	if err != nil {
-		return status.Error(codes.Internal, err.Error())
+		return nil, err
+	}
+	resp := &rocketpb.ListIngressClassesResponse{}
+	for i := range classes {
+		resp.IngressClasses = append(resp.IngressClasses, k8sutil.IngressClassToResponse(&classes[i]))
+	}
+	return resp, nil
+}
+
+func (r *rocketAPIServer) CheckHostAvailability(ctx context.Context, req *rocketpb.CheckHostAvailabilityRequest) (*rocketpb.CheckHostAvailabilityResponse, error) {
+	err := r.service.CheckHostAvailability(ctx, req.GetHost(), req.GetName(), req.GetNamespace())
+	switch status.Code(err) {
+	case codes.OK:
+		return &rocketpb.CheckHostAvailabilityResponse{Available: true}, nil
+	case codes.AlreadyExists, codes.InvalidArgument:
+		return &rocketpb.CheckHostAvailabilityResponse{Reason: status.Convert(err).Message()}, nil
+	default:
+		return nil, err
 	}
-	return nil
 }
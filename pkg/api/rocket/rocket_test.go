@@ -5,16 +5,27 @@ import (
 	"log"
 	"net"
 	"testing"
+	"time"
 
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
 	"github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/validator"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -27,7 +38,10 @@ var lis *bufconn.Listener
 
 func apiInit(service service.RocketService) {
 	lis = bufconn.Listen(bufSize)
-	s := grpc.NewServer()
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(validator.UnaryServerInterceptor(), apierror.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(validator.StreamServerInterceptor(), apierror.StreamServerInterceptor()),
+	)
 	rocketpb.RegisterRocketServiceServer(s, NewAPIServer(service))
 	go func() {
 		if err := s.Serve(lis); err != nil {
@@ -63,7 +77,8 @@ func TestGetAll(t *testing.T) {
 	}
 
 	expectedResponse := rocketpb.GetAllResponse{
-		Rockets: []*rocketpb.GetResponse{{Name: testName, Namespace: TestNamespace}},
+		Rockets:   []*rocketpb.GetResponse{{Name: testName, Namespace: TestNamespace, Metadata: &rocketpb.ObjectMeta{}}},
+		TotalSize: 1,
 	}
 
 	// create an instance of our test object
@@ -71,9 +86,12 @@ func TestGetAll(t *testing.T) {
 
 	// setup expectations
 	testService.
-		On("GetAll", mock.MatchedBy(func(_ context.Context) bool { return true }), TestNamespace).
-		Return(&v1alpha1.RocketList{
-			Items: rockets,
+		On("GetAll", mock.MatchedBy(func(_ context.Context) bool { return true }), mock.MatchedBy(func(req *rocketpb.GetAllRequest) bool {
+			return req.GetNamespace() == TestNamespace
+		})).
+		Return(&service.RocketPage{
+			Rockets:   rockets,
+			TotalSize: 1,
 		}, nil)
 
 	ctx := context.Background()
@@ -85,6 +103,7 @@ func TestGetAll(t *testing.T) {
 	// assert that the expectations were met
 	testService.AssertExpectations(t)
 	assert.Equal(t, expectedResponse.Rockets, resp.Rockets)
+	assert.Equal(t, expectedResponse.TotalSize, resp.TotalSize)
 }
 
 func TestGet_exists(t *testing.T) {
@@ -130,12 +149,277 @@ func TestGet_doesnt_exists(t *testing.T) {
 	// setup expectations
 	testService.
 		On("Get", mock.MatchedBy(func(_ context.Context) bool { return true }), testName, TestNamespace).
-		Return(nil, assert.AnError)
+		Return(nil, apiErrors.NewNotFound(v1alpha1.SchemeGroupVersion.WithResource("rockets").GroupResource(), testName))
 
 	ctx := context.Background()
 	client := connCreation(t, ctx, testService)
 	_, err := client.Get(ctx, &rocketpb.GetRequest{Namespace: TestNamespace, Name: testName})
 	// assert that the expectations were met
 	testService.AssertExpectations(t)
-	assert.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	details := status.Convert(err).Details()
+	if assert.Len(t, details, 1) {
+		info, ok := details[0].(*errdetails.ResourceInfo)
+		assert.True(t, ok)
+		assert.Equal(t, testName, info.ResourceName)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	testName := "test-update"
+
+	// create an instance of our test object
+	testService := new(testutils.MockedRocket)
+
+	// setup expectations
+	testService.
+		On("Update", mock.MatchedBy(func(_ context.Context) bool { return true }), mock.MatchedBy(func(req *rocketpb.UpdateRequest) bool {
+			return req.GetUpdatedRocket().GetName() == testName && req.GetUpdateMask().GetPaths()[0] == "host"
+		})).
+		Return(&v1alpha1.Rocket{
+			ObjectMeta: v1.ObjectMeta{Name: testName, Namespace: TestNamespace},
+		}, nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.Update(ctx, &rocketpb.UpdateRequest{
+		UpdatedRocket: &rocketpb.CreateRequest{Name: testName, Namespace: TestNamespace, Host: "chat.example.com"},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host"}},
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	// assert that the expectations were met
+	testService.AssertExpectations(t)
+	assert.True(t, resp.Successful)
+	assert.Equal(t, testName, resp.Rocket.Name)
+}
+
+func TestDelete_dryRun(t *testing.T) {
+	testName := "test-delete"
+
+	// create an instance of our test object
+	testService := new(testutils.MockedRocket)
+
+	// setup expectations
+	testService.
+		On("Delete", testName, TestNamespace, true).
+		Return(&v1alpha1.Rocket{
+			ObjectMeta: v1.ObjectMeta{Name: testName, Namespace: TestNamespace},
+		}, []string{"datadir-test-delete-mongodb-0"}, nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.Delete(ctx, &rocketpb.DeleteRequest{Name: testName, Namespace: TestNamespace, DryRun: true})
+	if err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	// assert that the expectations were met
+	testService.AssertExpectations(t)
+	assert.Equal(t, testName, resp.Rocket.Name)
+	assert.Equal(t, []string{"datadir-test-delete-mongodb-0"}, resp.VolumeClaims)
+}
+
+func TestCreate_invalid(t *testing.T) {
+	// create an instance of our test object
+	testService := new(testutils.MockedRocket)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	_, err := client.Create(ctx, &rocketpb.CreateRequest{
+		Name:         "Test_Rocket",
+		Host:         "chat.example.com",
+		Email:        "foo@example.com",
+		User:         "foo",
+		DatabaseSize: -1,
+		Replicas:     1,
+	})
+	// the service must not be called with an invalid request
+	testService.AssertNotCalled(t, "Create")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"name", "namespace", "database_size"}, fields)
+}
+
+func TestUpdate_invalid(t *testing.T) {
+	// create an instance of our test object
+	testService := new(testutils.MockedRocket)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	_, err := client.Update(ctx, &rocketpb.UpdateRequest{
+		UpdatedRocket: &rocketpb.CreateRequest{Name: "test-update", Namespace: TestNamespace, Replicas: 0},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"replicas"}},
+	})
+	testService.AssertNotCalled(t, "Update")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	details := status.Convert(err).Details()
+	if assert.Len(t, details, 1) {
+		badRequest := details[0].(*errdetails.BadRequest)
+		// fields that are not updated aren't validated
+		assert.Len(t, badRequest.FieldViolations, 1)
+		assert.Equal(t, "updated_rocket.replicas", badRequest.FieldViolations[0].Field)
+	}
+
+	// the identifying fields are always validated
+	_, err = client.Update(ctx, &rocketpb.UpdateRequest{
+		UpdatedRocket: &rocketpb.CreateRequest{Name: "test-update", Host: "chat.example.com"},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host"}},
+	})
+	testService.AssertNotCalled(t, "Update")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "empty namespace")
+}
+
+func TestCreate(t *testing.T) {
+	testName := "test-create"
+	created := time.Date(2021, 12, 10, 12, 0, 0, 0, time.UTC)
+
+	// create an instance of our test object
+	testService := new(testutils.MockedRocket)
+
+	// setup expectations
+	testService.
+		On("Create", mock.MatchedBy(func(_ context.Context) bool { return true }), "chat.example.com", testName, TestNamespace, "foo@example.com", "foo", "", "", "", int64(1), false).
+		Return(&v1alpha1.Rocket{
+			ObjectMeta: v1.ObjectMeta{
+				Name:              testName,
+				Namespace:         TestNamespace,
+				UID:               "6c3b8e4a-2d7f-4bb5-9d52-3f0b8c3f1e27",
+				ResourceVersion:   "42",
+				CreationTimestamp: v1.NewTime(created),
+			},
+			Spec: v1alpha1.RocketSpec{
+				Replicas:    1,
+				IngressSpec: v1alpha1.RocketIngressSpec{Host: "chat.example.com"},
+			},
+		}, nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.Create(ctx, &rocketpb.CreateRequest{
+		Name:         testName,
+		Namespace:    TestNamespace,
+		Host:         "chat.example.com",
+		Email:        "foo@example.com",
+		User:         "foo",
+		DatabaseSize: 1,
+		Replicas:     1,
+	})
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	// assert that the expectations were met
+	testService.AssertExpectations(t)
+	assert.Equal(t, testName, resp.Rocket.Name)
+	assert.Equal(t, "chat.example.com", resp.Rocket.Host)
+	assert.Equal(t, int32(1), resp.Rocket.Replicas)
+	assert.Equal(t, "6c3b8e4a-2d7f-4bb5-9d52-3f0b8c3f1e27", resp.Rocket.Metadata.Uid)
+	assert.Equal(t, "42", resp.Rocket.Metadata.ResourceVersion)
+	assert.Equal(t, created, resp.Rocket.Metadata.CreationTimestamp.AsTime())
+	assert.Nil(t, resp.Rocket.Metadata.DeletionTimestamp)
+}
+
+func TestGetIssuer(t *testing.T) {
+	issuer := &cmapi.Issuer{
+		ObjectMeta: v1.ObjectMeta{Name: "bar-issuer", Namespace: TestNamespace},
+		Spec: cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{
+			CA: &cmapi.CAIssuer{SecretName: "internal-ca"},
+		}},
+		Status: cmapi.IssuerStatus{Conditions: []cmapi.IssuerCondition{
+			{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse, Message: "secret not found"},
+		}},
+	}
+	testService := new(testutils.MockedRocket)
+	testService.
+		On("GetIssuer", mock.MatchedBy(func(_ context.Context) bool { return true }), TestNamespace, "bar").
+		Return(issuer, nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.GetIssuer(ctx, &rocketpb.GetIssuerRequest{Namespace: TestNamespace, User: "bar"})
+	if err != nil {
+		t.Fatalf("GetIssuer failed: %v", err)
+	}
+	testService.AssertExpectations(t)
+	assert.Equal(t, "bar-issuer", resp.GetName())
+	assert.Equal(t, "internal-ca", resp.GetCa().GetSecretName())
+	assert.False(t, resp.GetReady())
+	assert.Equal(t, "secret not found", resp.GetMessage())
+}
+
+func TestUpdateIssuer_invalid(t *testing.T) {
+	testService := new(testutils.MockedRocket)
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	// the mode is required
+	_, err := client.UpdateIssuer(ctx, &rocketpb.UpdateIssuerRequest{Namespace: TestNamespace, User: "bar"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	testService.AssertNotCalled(t, "UpdateIssuer", mock.Anything, mock.Anything)
+}
+
+func TestListIngressClasses(t *testing.T) {
+	testService := new(testutils.MockedRocket)
+	testService.
+		On("IngressClasses", mock.MatchedBy(func(_ context.Context) bool { return true })).
+		Return([]networkingv1.IngressClass{
+			{
+				ObjectMeta: v1.ObjectMeta{Name: "nginx"},
+				Spec:       networkingv1.IngressClassSpec{Controller: "k8s.io/ingress-nginx"},
+			},
+			{
+				ObjectMeta: v1.ObjectMeta{
+					Name:        "traefik",
+					Annotations: map[string]string{networkingv1.AnnotationIsDefaultIngressClass: "true"},
+				},
+				Spec: networkingv1.IngressClassSpec{Controller: "traefik.io/ingress-controller"},
+			},
+		}, nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.ListIngressClasses(ctx, &rocketpb.ListIngressClassesRequest{})
+	if err != nil {
+		t.Fatalf("ListIngressClasses failed: %v", err)
+	}
+	testService.AssertExpectations(t)
+	if assert.Len(t, resp.GetIngressClasses(), 2) {
+		assert.Equal(t, "nginx", resp.GetIngressClasses()[0].GetName())
+		assert.Equal(t, "k8s.io/ingress-nginx", resp.GetIngressClasses()[0].GetController())
+		assert.False(t, resp.GetIngressClasses()[0].GetIsDefault())
+		assert.True(t, resp.GetIngressClasses()[1].GetIsDefault())
+	}
+}
+
+func TestCheckHostAvailability(t *testing.T) {
+	testService := new(testutils.MockedRocket)
+	testService.
+		On("CheckHostAvailability", mock.MatchedBy(func(_ context.Context) bool { return true }), "chat.example.com", "", TestNamespace).
+		Return(status.Error(codes.AlreadyExists, "Host chat.example.com is already used by another rocket"))
+	testService.
+		On("CheckHostAvailability", mock.MatchedBy(func(_ context.Context) bool { return true }), "free.example.com", "", TestNamespace).
+		Return(nil)
+
+	ctx := context.Background()
+	client := connCreation(t, ctx, testService)
+	resp, err := client.CheckHostAvailability(ctx, &rocketpb.CheckHostAvailabilityRequest{Host: "chat.example.com", Namespace: TestNamespace})
+	if err != nil {
+		t.Fatalf("CheckHostAvailability failed: %v", err)
+	}
+	assert.False(t, resp.GetAvailable())
+	assert.Equal(t, "Host chat.example.com is already used by another rocket", resp.GetReason())
+
+	resp, err = client.CheckHostAvailability(ctx, &rocketpb.CheckHostAvailabilityRequest{Host: "free.example.com", Namespace: TestNamespace})
+	if err != nil {
+		t.Fatalf("CheckHostAvailability failed: %v", err)
+	}
+	assert.True(t, resp.GetAvailable())
+	testService.AssertExpectations(t)
 }
@@ -0,0 +1,104 @@
+package tenant
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	tenantService "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service/tenant"
+	tenantpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/tenant/v1"
+)
+
+type tenantAPIServer struct {
+	service service.TenantService
+}
+
+func NewAPIServer(service service.TenantService) *tenantAPIServer {
+	return &tenantAPIServer{
+		service: service,
+	}
+}
+
+func (t *tenantAPIServer) Register(ctx context.Context, req *tenantpb.RegisterRequest) (*tenantpb.RegisterResponse, error) {
+	cpu, err := parseQuantity("cpu", req.GetCpu())
+	if err != nil {
+		return nil, err
+	}
+	memory, err := parseQuantity("memory", req.GetMemory())
+	if err != nil {
+		return nil, err
+	}
+	storage, err := parseQuantity("storage", req.GetStorage())
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := t.service.Register(ctx, cpu, memory, storage)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantpb.RegisterResponse{Tenant: tenantToResponse(tenant)}, nil
+}
+
+func (t *tenantAPIServer) Get(ctx context.Context, req *tenantpb.GetRequest) (*tenantpb.GetResponse, error) {
+	tenant, err := t.service.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantpb.GetResponse{Tenant: tenantToResponse(tenant)}, nil
+}
+
+func (t *tenantAPIServer) List(ctx context.Context, req *tenantpb.ListRequest) (*tenantpb.ListResponse, error) {
+	tenants, err := t.service.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &tenantpb.ListResponse{}
+	for i := range tenants {
+		resp.Tenants = append(resp.Tenants, tenantToResponse(&tenants[i]))
+	}
+	return resp, nil
+}
+
+func (t *tenantAPIServer) Deregister(ctx context.Context, req *tenantpb.DeregisterRequest) (*tenantpb.DeregisterResponse, error) {
+	tenant, err := t.service.Deregister(ctx, req.GetForce())
+	if err != nil {
+		return nil, err
+	}
+	return &tenantpb.DeregisterResponse{Tenant: tenantToResponse(tenant)}, nil
+}
+
+func parseQuantity(field, value string) (resource.Quantity, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return resource.Quantity{}, status.Errorf(codes.InvalidArgument, "Invalid %v: %v", field, err)
+	}
+	return quantity, nil
+}
+
+// tenantToResponse converts the tenant to the representation returned by the api
+func tenantToResponse(tenant *service.Tenant) *tenantpb.Tenant {
+	namespace := tenant.Namespace
+	resp := &tenantpb.Tenant{
+		Namespace: namespace.Name,
+		Subject:   namespace.Annotations[tenantService.SubjectAnnotation],
+		Phase:     string(namespace.Status.Phase),
+	}
+	if !namespace.CreationTimestamp.IsZero() {
+		resp.CreationTimestamp = timestamppb.New(namespace.CreationTimestamp.Time)
+	}
+	if tenant.Quota != nil {
+		resp.Hard = make(map[string]string)
+		for name, quantity := range tenant.Quota.Spec.Hard {
+			resp.Hard[string(name)] = quantity.String()
+		}
+		resp.Used = make(map[string]string)
+		for name, quantity := range tenant.Quota.Status.Used {
+			resp.Used[string(name)] = quantity.String()
+		}
+	}
+	return resp
+}
@@ -0,0 +1,104 @@
+package tenant
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/validator"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	tenantService "github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service/tenant"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	tenantpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/tenant/v1"
+)
+
+const bufSize = 1024 * 1024
+
+func connCreation(t *testing.T, ctx context.Context, testService *testutils.MockedTenant) tenantpb.TenantServiceClient {
+	lis := bufconn.Listen(bufSize)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(validator.UnaryServerInterceptor(), apierror.UnaryServerInterceptor()),
+	)
+	tenantpb.RegisterTenantServiceServer(s, NewAPIServer(testService))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(ctx, "bufnet", grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+		return lis.Dial()
+	}), grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	return tenantpb.NewTenantServiceClient(conn)
+}
+
+func TestRegister(t *testing.T) {
+	tenant := &service.Tenant{
+		Namespace: corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "tenant-alice",
+				Annotations: map[string]string{tenantService.SubjectAnnotation: "alice"},
+			},
+			Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+		},
+		Quota: &corev1.ResourceQuota{
+			Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourceLimitsCPU: resource.MustParse("2")}},
+		},
+	}
+	tests := []struct {
+		name     string
+		req      *tenantpb.RegisterRequest
+		want     *tenantpb.Tenant
+		wantCode codes.Code
+	}{
+		{
+			name: "register",
+			req:  &tenantpb.RegisterRequest{Cpu: "2", Memory: "4Gi", Storage: "20Gi"},
+			want: &tenantpb.Tenant{
+				Namespace: "tenant-alice",
+				Subject:   "alice",
+				Phase:     "Active",
+				Hard:      map[string]string{"limits.cpu": "2"},
+				Used:      map[string]string{},
+			},
+		},
+		{
+			name:     "invalid quantity",
+			req:      &tenantpb.RegisterRequest{Cpu: "two", Memory: "4Gi", Storage: "20Gi"},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "missing storage",
+			req:      &tenantpb.RegisterRequest{Cpu: "2", Memory: "4Gi"},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			testService := new(testutils.MockedTenant)
+			testService.On("Register", mock.Anything, resource.MustParse("2"), resource.MustParse("4Gi"), resource.MustParse("20Gi")).Return(tenant, nil)
+			client := connCreation(t, ctx, testService)
+
+			resp, err := client.Register(ctx, tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				testService.AssertNotCalled(t, "Register", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.String(), resp.GetTenant().String())
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Package apierror translates errors of the kubernetes api into grpc status errors
+package apierror
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type grpcStatus interface {
+	GRPCStatus() *status.Status
+}
+
+// ToStatus converts err into a grpc status error.
+// Errors wrapping a grpc status keep their code, kubernetes api errors are mapped by their reason
+// and carry ResourceInfo, BadRequest or QuotaFailure details. All other errors become Internal.
+func ToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if s, ok := err.(grpcStatus); ok {
+		return s.GRPCStatus().Err()
+	}
+
+	var wrappedStatus grpcStatus
+	if errors.As(err, &wrappedStatus) {
+		s := wrappedStatus.GRPCStatus()
+		// keep the context of the wrapping errors in front of the status message
+		p := s.Proto()
+		p.Message = strings.TrimSuffix(err.Error(), s.Err().Error()) + s.Message()
+		return status.FromProto(p).Err()
+	}
+
+	var apiStatus apiErrors.APIStatus
+	if errors.As(err, &apiStatus) {
+		return fromAPIStatus(apiStatus.Status(), err.Error())
+	}
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		// the kubernetes api can't be reached
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func fromAPIStatus(apiStatus metav1.Status, message string) error {
+	var code codes.Code
+	switch apiStatus.Reason {
+	case metav1.StatusReasonNotFound:
+		code = codes.NotFound
+	case metav1.StatusReasonAlreadyExists:
+		code = codes.AlreadyExists
+	case metav1.StatusReasonForbidden:
+		code = codes.PermissionDenied
+		if isQuotaExceeded(apiStatus) {
+			code = codes.ResourceExhausted
+		}
+	case metav1.StatusReasonUnauthorized:
+		code = codes.Unauthenticated
+	case metav1.StatusReasonConflict:
+		code = codes.FailedPrecondition
+	case metav1.StatusReasonInvalid, metav1.StatusReasonBadRequest:
+		code = codes.InvalidArgument
+	case metav1.StatusReasonTooManyRequests, metav1.StatusReasonRequestEntityTooLarge:
+		code = codes.ResourceExhausted
+	case metav1.StatusReasonTimeout, metav1.StatusReasonServerTimeout:
+		code = codes.DeadlineExceeded
+	case metav1.StatusReasonServiceUnavailable:
+		code = codes.Unavailable
+	case metav1.StatusReasonGone, metav1.StatusReasonExpired:
+		code = codes.OutOfRange
+	case metav1.StatusReasonMethodNotAllowed:
+		code = codes.Unimplemented
+	default:
+		code = codes.Internal
+	}
+
+	s := status.New(code, message)
+	details := apiStatus.Details
+	if details == nil {
+		return s.Err()
+	}
+
+	var detailed *status.Status
+	var err error
+	switch code {
+	case codes.InvalidArgument:
+		badRequest := &errdetails.BadRequest{}
+		for _, cause := range details.Causes {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       cause.Field,
+				Description: cause.Message,
+			})
+		}
+		detailed, err = s.WithDetails(resourceInfo(details, apiStatus.Message), badRequest)
+	case codes.ResourceExhausted:
+		detailed, err = s.WithDetails(resourceInfo(details, apiStatus.Message), &errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     details.Kind + "/" + details.Name,
+				Description: apiStatus.Message,
+			}},
+		})
+	default:
+		detailed, err = s.WithDetails(resourceInfo(details, apiStatus.Message))
+	}
+	if err != nil {
+		return s.Err()
+	}
+	return detailed.Err()
+}
+
+func resourceInfo(details *metav1.StatusDetails, message string) *errdetails.ResourceInfo {
+	resourceType := details.Kind
+	if details.Group != "" {
+		resourceType = details.Group + "/" + details.Kind
+	}
+	return &errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: details.Name,
+		Description:  message,
+	}
+}
+
+// isQuotaExceeded reports if the forbidden status was caused by a ResourceQuota
+func isQuotaExceeded(apiStatus metav1.Status) bool {
+	return strings.Contains(apiStatus.Message, "exceeded quota")
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that converts the errors of handlers with ToStatus
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		return resp, ToStatus(err)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that converts the errors of handlers with ToStatus
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return ToStatus(handler(srv, stream))
+	}
+}
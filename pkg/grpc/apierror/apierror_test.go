@@ -0,0 +1,126 @@
+package apierror
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var rockets = chatv1alpha1.SchemeGroupVersion.WithResource("rockets").GroupResource()
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode codes.Code
+	}{
+		{
+			name:     "not found",
+			err:      apiErrors.NewNotFound(rockets, "foo"),
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "wrapped not found",
+			err:      fmt.Errorf("error getting rocket from cluster api: %w", apiErrors.NewNotFound(rockets, "foo")),
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "already exists",
+			err:      apiErrors.NewAlreadyExists(rockets, "foo"),
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "forbidden",
+			err:      apiErrors.NewForbidden(rockets, "foo", fmt.Errorf("user can't get rockets")),
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "quota exceeded",
+			err:      apiErrors.NewForbidden(schema.GroupResource{Resource: "persistentvolumeclaims"}, "foo", fmt.Errorf("exceeded quota: tenant, requested: requests.storage=10Gi")),
+			wantCode: codes.ResourceExhausted,
+		},
+		{
+			name:     "unauthorized",
+			err:      apiErrors.NewUnauthorized("token expired"),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "conflict",
+			err:      apiErrors.NewConflict(rockets, "foo", fmt.Errorf("object has been modified")),
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "cluster down",
+			err:      &net.OpError{Op: "dial", Net: "tcp", Err: fmt.Errorf("connection refused")},
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "canceled",
+			err:      fmt.Errorf("watching rocket: %w", context.Canceled),
+			wantCode: codes.Canceled,
+		},
+		{
+			name:     "status error",
+			err:      status.Error(codes.InvalidArgument, "Namespace can't be empty"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "wrapped status error",
+			err:      fmt.Errorf("Error getting token: %w", status.Error(codes.Unauthenticated, "no token")),
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "unknown error",
+			err:      fmt.Errorf("foo"),
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ToStatus(tt.err)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestToStatus_message(t *testing.T) {
+	err := ToStatus(fmt.Errorf("Error getting token: %w", status.Error(codes.Unauthenticated, "no token")))
+	assert.Equal(t, "Error getting token: no token", status.Convert(err).Message())
+
+	err = ToStatus(fmt.Errorf("error getting rocket from cluster api: %w", apiErrors.NewNotFound(rockets, "foo")))
+	assert.Equal(t, `error getting rocket from cluster api: rockets.chat.accso.de "foo" not found`, status.Convert(err).Message())
+}
+
+func TestToStatus_details(t *testing.T) {
+	err := ToStatus(apiErrors.NewNotFound(rockets, "foo"))
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+	info, ok := details[0].(*errdetails.ResourceInfo)
+	assert.True(t, ok)
+	assert.Equal(t, "chat.accso.de/rockets", info.ResourceType)
+	assert.Equal(t, "foo", info.ResourceName)
+
+	err = ToStatus(apiErrors.NewInvalid(chatv1alpha1.SchemeGroupVersion.WithKind("Rocket").GroupKind(), "foo", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "replicas"), -1, "must be greater than or equal to 0"),
+	}))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	var badRequest *errdetails.BadRequest
+	for _, detail := range status.Convert(err).Details() {
+		if d, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest = d
+		}
+	}
+	if assert.NotNil(t, badRequest) {
+		assert.Equal(t, "spec.replicas", badRequest.FieldViolations[0].Field)
+	}
+}
@@ -0,0 +1,83 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// logsArchivePath serves the archive of the logs of a rocket as download,
+// the window of the logs is set by the since_seconds or since_time (RFC 3339) query parameters
+const logsArchivePath = "/v1/namespaces/{namespace}/rockets/{name}/logs.tar.gz"
+
+// maxArchiveSize is the maximum size of log archives received from the grpc server,
+// it leaves room for the tar headers besides the logs
+const maxArchiveSize = service.MaxLogsArchiveSize + 1<<20
+
+// logsArchiveHandler calls LogsArchive with the authorization of the request and writes the archive as response body
+func logsArchiveHandler(mux *runtime.ServeMux, client rocketpb.RocketServiceClient) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		_, outbound := runtime.MarshalerForRequest(mux, r)
+		ctx, err := runtime.AnnotateContext(ctx, mux, r, "/rocket.v1.RocketService/LogsArchive")
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		req, err := logsArchiveRequest(r, pathParams)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		resp, err := client.LogsArchive(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outbound, w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", resp.GetFilename()))
+		w.Header().Set("Content-Length", strconv.Itoa(len(resp.GetArchive())))
+		if _, err := w.Write(resp.GetArchive()); err != nil {
+			// the status is already sent, like the handlers of the gateway the error is only logged
+			grpclog.Infof("Failed to write response: %v", err)
+		}
+	}
+}
+
+func logsArchiveRequest(r *http.Request, pathParams map[string]string) (*rocketpb.LogsArchiveRequest, error) {
+	req := &rocketpb.LogsArchiveRequest{
+		Name:      pathParams["name"],
+		Namespace: pathParams["namespace"],
+	}
+	query := r.URL.Query()
+	if seconds := query.Get("since_seconds"); seconds != "" {
+		sinceSeconds, err := strconv.ParseInt(seconds, 10, 64)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid since_seconds: %v", err)
+		}
+		req.Since = &rocketpb.LogsArchiveRequest_SinceSeconds{SinceSeconds: sinceSeconds}
+	}
+	if since := query.Get("since_time"); since != "" {
+		if req.Since != nil {
+			return nil, status.Error(codes.InvalidArgument, "Only one of since_seconds and since_time can be set")
+		}
+		sinceTime, err := time.Parse(time.RFC3339, since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid since_time: %v", err)
+		}
+		req.Since = &rocketpb.LogsArchiveRequest_SinceTime{SinceTime: timestamppb.New(sinceTime)}
+	}
+	return req, nil
+}
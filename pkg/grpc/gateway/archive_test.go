@@ -0,0 +1,112 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// archiveClient only implements LogsArchive of the rocket service
+type archiveClient struct {
+	rocketpb.RocketServiceClient
+	req *rocketpb.LogsArchiveRequest
+	md  metadata.MD
+	err error
+}
+
+func (c *archiveClient) LogsArchive(ctx context.Context, req *rocketpb.LogsArchiveRequest, opts ...grpc.CallOption) (*rocketpb.LogsArchiveResponse, error) {
+	c.req = req
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &rocketpb.LogsArchiveResponse{Archive: []byte("archive"), Filename: "default-foo-logs.tar.gz"}, nil
+}
+
+func TestLogsArchiveHandler(t *testing.T) {
+	since := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		url        string
+		err        error
+		wantReq    *rocketpb.LogsArchiveRequest
+		wantStatus int
+	}{
+		{
+			name:       "archive",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz",
+			wantReq:    &rocketpb.LogsArchiveRequest{Name: "foo", Namespace: "default"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "since seconds",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz?since_seconds=60",
+			wantReq:    &rocketpb.LogsArchiveRequest{Name: "foo", Namespace: "default", Since: &rocketpb.LogsArchiveRequest_SinceSeconds{SinceSeconds: 60}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "since time",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz?since_time=" + since.Format(time.RFC3339),
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "invalid since",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz?since_seconds=an-hour",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "both since",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz?since_seconds=60&since_time=" + since.Format(time.RFC3339),
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "denied",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz",
+			err:        status.Error(codes.PermissionDenied, "forbidden"),
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "too large",
+			url:        "/v1/namespaces/default/rockets/foo/logs.tar.gz",
+			err:        status.Error(codes.ResourceExhausted, "too large"),
+			wantStatus: http.StatusTooManyRequests,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := runtime.NewServeMux()
+			client := &archiveClient{err: tt.err}
+			assert.NoError(t, mux.HandlePath(http.MethodGet, logsArchivePath, logsArchiveHandler(mux, client)))
+
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set("Authorization", "Bearer token")
+			rec := httptest.NewRecorder()
+			mux.ServeHTTP(rec, req)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, "application/gzip", rec.Header().Get("Content-Type"))
+			assert.Equal(t, `attachment; filename="default-foo-logs.tar.gz"`, rec.Header().Get("Content-Disposition"))
+			assert.Equal(t, "archive", rec.Body.String())
+			// the token of the caller authorizes the call
+			assert.Equal(t, []string{"Bearer token"}, client.md.Get("authorization"))
+			if tt.wantReq != nil {
+				assert.Equal(t, tt.wantReq.String(), client.req.String())
+			} else {
+				assert.True(t, since.Equal(client.req.GetSinceTime().AsTime()))
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	rocketgw "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	tenantgw "github.com/bachelor-thesis-hown3d/chat-api-server/proto/tenant/v1"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -18,8 +19,23 @@ func Run(logger *zap.Logger, grpcServerEndpoint, addr string) error {
 	// Register gRPC server endpoint
 	// Note: Make sure the gRPC server is running properly and accessible
 	mux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
-	err := rocketgw.RegisterRocketServiceHandlerFromEndpoint(ctx, mux, grpcServerEndpoint, opts)
+	conn, err := grpc.DialContext(ctx, grpcServerEndpoint,
+		grpc.WithInsecure(),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxArchiveSize)),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	err = rocketgw.RegisterRocketServiceHandler(ctx, mux, conn)
+	if err != nil {
+		return err
+	}
+	err = tenantgw.RegisterTenantServiceHandler(ctx, mux, conn)
+	if err != nil {
+		return err
+	}
+	err = mux.HandlePath(http.MethodGet, logsArchivePath, logsArchiveHandler(mux, rocketgw.NewRocketServiceClient(conn)))
 	if err != nil {
 		return err
 	}
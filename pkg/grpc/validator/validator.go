@@ -0,0 +1,153 @@
+// Package validator enforces the validation rules declared on the proto messages
+package validator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// validatorAll is implemented by messages generated by protoc-gen-validate
+type validatorAll interface {
+	ValidateAll() error
+}
+
+// multiError is implemented by the errors returned from ValidateAll
+type multiError interface {
+	AllErrors() []error
+}
+
+// fieldError is implemented by the errors of a single field violation
+type fieldError interface {
+	Field() string
+	Reason() string
+	Cause() error
+}
+
+// Validate checks all rules of msg and returns an InvalidArgument error with a BadRequest detail
+// listing every violated field. Messages without rules are always valid.
+func Validate(msg interface{}) error {
+	return ValidateFields(msg, "", nil)
+}
+
+// ValidateFields is like Validate, but only reports violations of the top level fields named by fields.
+// All violations are reported if no fields are given.
+// The reported field paths are prefixed with prefix, which is used for messages embedded in a request.
+func ValidateFields(msg interface{}, prefix string, fields []string) error {
+	v, ok := msg.(validatorAll)
+	if !ok {
+		return nil
+	}
+	err := v.ValidateAll()
+	if err == nil {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, violation := range fieldViolations(err, "") {
+		if len(fields) > 0 && !contains(fields, strings.SplitN(violation.Field, ".", 2)[0]) {
+			continue
+		}
+		violation.Field = prefix + violation.Field
+		badRequest.FieldViolations = append(badRequest.FieldViolations, violation)
+	}
+	if len(badRequest.FieldViolations) == 0 {
+		return nil
+	}
+
+	descriptions := make([]string, 0, len(badRequest.FieldViolations))
+	for _, violation := range badRequest.FieldViolations {
+		descriptions = append(descriptions, violation.Field+": "+violation.Description)
+	}
+	s := status.New(codes.InvalidArgument, "Invalid request: "+strings.Join(descriptions, "; "))
+	detailed, err := s.WithDetails(badRequest)
+	if err != nil {
+		return s.Err()
+	}
+	return detailed.Err()
+}
+
+// fieldViolations flattens the errors of ValidateAll into violations of the field paths of the message.
+// Violations of embedded messages are prefixed with the path of their parent field.
+func fieldViolations(err error, prefix string) []*errdetails.BadRequest_FieldViolation {
+	var multi multiError
+	if errors.As(err, &multi) {
+		var violations []*errdetails.BadRequest_FieldViolation
+		for _, e := range multi.AllErrors() {
+			violations = append(violations, fieldViolations(e, prefix)...)
+		}
+		return violations
+	}
+
+	var fe fieldError
+	if !errors.As(err, &fe) {
+		return []*errdetails.BadRequest_FieldViolation{{Field: strings.TrimSuffix(prefix, "."), Description: err.Error()}}
+	}
+	field := prefix + snakeCase(fe.Field())
+	if cause := fe.Cause(); cause != nil {
+		var nested fieldError
+		var nestedMulti multiError
+		if errors.As(cause, &nested) || errors.As(cause, &nestedMulti) {
+			return fieldViolations(cause, field+".")
+		}
+	}
+	return []*errdetails.BadRequest_FieldViolation{{Field: field, Description: fe.Reason()}}
+}
+
+// snakeCase converts the go name of a field used by protoc-gen-validate back to its proto name
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// UnaryServerInterceptor returns a new unary server interceptor that rejects invalid requests before calling the handler
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := Validate(req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a new stream server interceptor that rejects invalid messages received on the stream
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: stream})
+	}
+}
+
+type validatingStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return Validate(m)
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"testing"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func validCreateRequest() *rocketpb.CreateRequest {
+	return &rocketpb.CreateRequest{
+		Name:         "test-rocket",
+		Namespace:    "test-ns",
+		DatabaseSize: 10,
+		Email:        "foo@example.com",
+		User:         "foo",
+		Replicas:     1,
+		Host:         "chat.example.com",
+	}
+}
+
+func violatedFields(err error) []string {
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.FieldViolations {
+				fields = append(fields, violation.Field)
+			}
+		}
+	}
+	return fields
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		msg        interface{}
+		wantFields []string
+	}{
+		{
+			name: "valid create request",
+			msg:  validCreateRequest(),
+		},
+		{
+			name: "invalid names",
+			msg: func() *rocketpb.CreateRequest {
+				req := validCreateRequest()
+				req.Name = "Test.Rocket"
+				req.Namespace = ""
+				req.User = "-foo"
+				return req
+			}(),
+			wantFields: []string{"name", "namespace", "user"},
+		},
+		{
+			name: "invalid host and email",
+			msg: func() *rocketpb.CreateRequest {
+				req := validCreateRequest()
+				req.Host = "chat..example.com"
+				req.Email = "foo"
+				return req
+			}(),
+			wantFields: []string{"host", "email"},
+		},
+		{
+			name: "bounds",
+			msg: func() *rocketpb.CreateRequest {
+				req := validCreateRequest()
+				req.DatabaseSize = -1
+				req.Replicas = 0
+				return req
+			}(),
+			wantFields: []string{"database_size", "replicas"},
+		},
+		{
+			name: "invalid version",
+			msg: func() *rocketpb.CreateRequest {
+				req := validCreateRequest()
+				req.RocketVersion = "3.18.2 && rm -rf"
+				return req
+			}(),
+			wantFields: []string{"rocket_version"},
+		},
+		{
+			name: "get all without namespace",
+			msg:  &rocketpb.GetAllRequest{},
+		},
+		{
+			name:       "unspecified image",
+			msg:        &rocketpb.AvailableVersionsRequest{},
+			wantFields: []string{"image"},
+		},
+		{
+			name:       "update without rocket",
+			msg:        &rocketpb.UpdateRequest{},
+			wantFields: []string{"updated_rocket"},
+		},
+		{
+			name: "update skips rules of the rocket",
+			msg:  &rocketpb.UpdateRequest{UpdatedRocket: &rocketpb.CreateRequest{Name: "test-rocket"}},
+		},
+		{
+			name: "message without rules",
+			msg:  "foo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.msg)
+			if tt.wantFields == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.ElementsMatch(t, tt.wantFields, violatedFields(err))
+		})
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	req := &rocketpb.CreateRequest{Name: "test-rocket", Namespace: "test-ns", Host: "foo_bar"}
+	err := ValidateFields(req, "updated_rocket.", []string{"name", "namespace", "host"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"updated_rocket.host"}, violatedFields(err))
+
+	req.Host = "chat.example.com"
+	assert.NoError(t, ValidateFields(req, "updated_rocket.", []string{"name", "namespace", "host"}))
+}
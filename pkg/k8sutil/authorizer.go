@@ -0,0 +1,105 @@
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DefaultAccessReviewTTL is the time the decision of an access review is reused for requests of the same user
+const DefaultAccessReviewTTL = 10 * time.Second
+
+// Authorizer decides if the user of a request may access resources the api-server reads with its own credentials
+type Authorizer interface {
+	// Authorize returns a PermissionDenied error if the user of ctx isn't allowed to access the resource of attributes
+	Authorize(ctx context.Context, attributes authorizationv1.ResourceAttributes) error
+}
+
+type accessDecision struct {
+	allowed bool
+	reason  string
+	expiry  time.Time
+}
+
+// AccessReviewAuthorizer asks the cluster if a user is allowed to access a resource.
+// The access review is created with the clients of the user, so the cluster resolves the user
+// exactly as for requests that aren't served from the cache.
+// Decisions are cached for the ttl, keyed by the hash of the token and the attributes.
+type AccessReviewAuthorizer struct {
+	clients ClientFactory
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu        sync.Mutex
+	decisions map[string]accessDecision
+}
+
+// NewAccessReviewAuthorizer returns an Authorizer creating access reviews with the clients of the factory
+func NewAccessReviewAuthorizer(clients ClientFactory, ttl time.Duration) *AccessReviewAuthorizer {
+	return &AccessReviewAuthorizer{
+		clients:   clients,
+		ttl:       ttl,
+		now:       time.Now,
+		decisions: make(map[string]accessDecision),
+	}
+}
+
+func (a *AccessReviewAuthorizer) Authorize(ctx context.Context, attributes authorizationv1.ResourceAttributes) error {
+	token, err := oauth.GetAuthTokenFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("Error getting token: %w", err)
+	}
+	key := fmt.Sprintf("%v/%v/%v/%v/%v/%v", tokenHash(token), attributes.Verb, attributes.Group, attributes.Resource, attributes.Namespace, attributes.Name)
+	now := a.now()
+
+	a.mu.Lock()
+	decision, ok := a.decisions[key]
+	a.mu.Unlock()
+	if !ok || !now.Before(decision.expiry) {
+		decision, err = a.review(ctx, attributes)
+		if err != nil {
+			return err
+		}
+		decision.expiry = now.Add(a.ttl)
+		a.store(key, decision, now)
+	}
+
+	if !decision.allowed {
+		return status.Errorf(codes.PermissionDenied, "User is not allowed to %v %v in namespace %q: %v",
+			attributes.Verb, attributes.Resource, attributes.Namespace, decision.reason)
+	}
+	return nil
+}
+
+func (a *AccessReviewAuthorizer) review(ctx context.Context, attributes authorizationv1.ResourceAttributes) (accessDecision, error) {
+	kubeclient, err := a.clients.KubeClient(ctx)
+	if err != nil {
+		return accessDecision{}, fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+	}
+	review, err := kubeclient.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: &attributes},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return accessDecision{}, fmt.Errorf("Error reviewing access to %v: %w", attributes.Resource, err)
+	}
+	return accessDecision{allowed: review.Status.Allowed, reason: review.Status.Reason}, nil
+}
+
+// store adds the decision to the cache and removes the expired decisions
+func (a *AccessReviewAuthorizer) store(key string, decision accessDecision, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for k, d := range a.decisions {
+		if !now.Before(d.expiry) {
+			delete(a.decisions, k)
+		}
+	}
+	a.decisions[key] = decision
+}
@@ -0,0 +1,76 @@
+package k8sutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+type kubeClientFactory struct {
+	kubeclient kubernetes.Interface
+}
+
+func (f kubeClientFactory) KubeClient(_ context.Context) (kubernetes.Interface, error) {
+	return f.kubeclient, nil
+}
+
+func (f kubeClientFactory) ChatClient(_ context.Context) (chatv1alpha1.ChatV1alpha1Interface, error) {
+	return nil, nil
+}
+
+func (f kubeClientFactory) CertManagerClient(_ context.Context) (certmanager.Interface, error) {
+	return nil, nil
+}
+
+func TestAccessReviewAuthorizer_Authorize(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset()
+	reviews := 0
+	kubeclient.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		reviews++
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = review.Spec.ResourceAttributes.Namespace == "allowed"
+		if !review.Status.Allowed {
+			review.Status.Reason = "no RBAC policy matched"
+		}
+		return true, review, nil
+	})
+
+	now := time.Now()
+	a := NewAccessReviewAuthorizer(kubeClientFactory{kubeclient: kubeclient}, time.Minute)
+	a.now = func() time.Time { return now }
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer user-token"))
+	allowed := authorizationv1.ResourceAttributes{Verb: "get", Group: "chat.accso.de", Resource: "rockets", Namespace: "allowed"}
+	denied := authorizationv1.ResourceAttributes{Verb: "get", Group: "chat.accso.de", Resource: "rockets", Namespace: "denied"}
+
+	assert.NoError(t, a.Authorize(ctx, allowed))
+	err := a.Authorize(ctx, denied)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, 2, reviews)
+
+	// decisions are reused until they expire
+	assert.NoError(t, a.Authorize(ctx, allowed))
+	assert.Error(t, a.Authorize(ctx, denied))
+	assert.Equal(t, 2, reviews)
+	now = now.Add(2 * time.Minute)
+	assert.NoError(t, a.Authorize(ctx, allowed))
+	assert.Equal(t, 3, reviews)
+
+	// decisions are not shared between users
+	other := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer other-token"))
+	assert.NoError(t, a.Authorize(other, allowed))
+	assert.Equal(t, 4, reviews)
+
+	assert.Error(t, a.Authorize(context.Background(), allowed), "requests without token must be rejected")
+}
@@ -0,0 +1,191 @@
+package k8sutil
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+// DefaultTokenTTL is the lifetime of cached clientsets for tokens without an exp claim
+const DefaultTokenTTL = 5 * time.Minute
+
+// Clientsets are the clients created for the token of a user
+type Clientsets struct {
+	Kube        kubernetes.Interface
+	Chat        chatv1alpha1.ChatV1alpha1Interface
+	CertManager certmanager.Interface
+}
+
+type cacheEntry struct {
+	key        string
+	clientsets *Clientsets
+	expiry     time.Time
+}
+
+// ClientsetCache is a size bounded LRU cache of clientsets, keyed by the hash of the token they were created for.
+// Entries expire when the exp claim of the token passes.
+// All cached clientsets with the same TLS config share a single transport.
+type ClientsetCache struct {
+	size int
+	now  func() time.Time
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+
+	transportsMu sync.Mutex
+	transports   map[string]http.RoundTripper
+
+	hits   uint64
+	misses uint64
+}
+
+// NewClientsetCache returns a cache holding the clientsets of at most size tokens
+func NewClientsetCache(size int) *ClientsetCache {
+	return &ClientsetCache{
+		size:       size,
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    make(map[string]*list.Element),
+		transports: make(map[string]http.RoundTripper),
+	}
+}
+
+// Get returns the cached clientsets of token.
+// If there are none or they are expired, new clientsets are created with create and added to the cache.
+func (c *ClientsetCache) Get(token string, create func() (*Clientsets, error)) (*Clientsets, error) {
+	key := tokenHash(token)
+	now := c.now()
+
+	c.mu.Lock()
+	if elem, ok := c.entries[key]; ok {
+		entry := elem.Value.(*cacheEntry)
+		if now.Before(entry.expiry) {
+			c.lru.MoveToFront(elem)
+			c.mu.Unlock()
+			atomic.AddUint64(&c.hits, 1)
+			return entry.clientsets, nil
+		}
+		c.remove(elem)
+	}
+	c.mu.Unlock()
+	atomic.AddUint64(&c.misses, 1)
+
+	clientsets, err := create()
+	if err != nil {
+		return nil, err
+	}
+	expiry := tokenExpiry(token, now)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		// created concurrently by another request
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(&cacheEntry{key: key, clientsets: clientsets, expiry: expiry})
+	for c.lru.Len() > c.size {
+		c.remove(c.lru.Back())
+	}
+	return clientsets, nil
+}
+
+// remove needs to be called with the lock held
+func (c *ClientsetCache) remove(elem *list.Element) {
+	c.lru.Remove(elem)
+	delete(c.entries, elem.Value.(*cacheEntry).key)
+}
+
+// Len returns the amount of cached clientsets
+func (c *ClientsetCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Hits returns how often cached clientsets were returned
+func (c *ClientsetCache) Hits() uint64 {
+	return atomic.LoadUint64(&c.hits)
+}
+
+// Misses returns how often new clientsets had to be created
+func (c *ClientsetCache) Misses() uint64 {
+	return atomic.LoadUint64(&c.misses)
+}
+
+// SharedTransportConfig returns a copy of config that uses the transport shared by all configs with the same TLS settings.
+// Authentication settings of config are kept and applied on top of the shared transport.
+func (c *ClientsetCache) SharedTransportConfig(config *rest.Config) (*rest.Config, error) {
+	rt, err := c.transportFor(config)
+	if err != nil {
+		return nil, err
+	}
+	shared := rest.CopyConfig(config)
+	shared.TLSClientConfig = rest.TLSClientConfig{}
+	shared.Transport = rt
+	return shared, nil
+}
+
+func (c *ClientsetCache) transportFor(config *rest.Config) (http.RoundTripper, error) {
+	tls := config.TLSClientConfig
+	key := fmt.Sprintf("%v/%v/%v/%x/%x/%x", config.Host, tls.ServerName, tls.Insecure, tls.CAData, tls.CertData, tls.KeyData)
+	if tls.CAFile != "" || tls.CertFile != "" || tls.KeyFile != "" {
+		key += fmt.Sprintf("/%v/%v/%v", tls.CAFile, tls.CertFile, tls.KeyFile)
+	}
+
+	c.transportsMu.Lock()
+	defer c.transportsMu.Unlock()
+	if rt, ok := c.transports[key]; ok {
+		return rt, nil
+	}
+	// only the tls settings are used for the shared transport, authentication is added per config
+	rt, err := rest.TransportFor(&rest.Config{
+		Host:            config.Host,
+		TLSClientConfig: tls,
+		Proxy:           config.Proxy,
+		Dial:            config.Dial,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating transport: %w", err)
+	}
+	c.transports[key] = rt
+	return rt, nil
+}
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// tokenExpiry returns the time of the exp claim of a JWT.
+// Tokens without a readable exp claim expire after the DefaultTokenTTL.
+func tokenExpiry(token string, now time.Time) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return now.Add(DefaultTokenTTL)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return now.Add(DefaultTokenTTL)
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return now.Add(DefaultTokenTTL)
+	}
+	return time.Unix(claims.Exp, 0)
+}
@@ -0,0 +1,95 @@
+package k8sutil
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/rest"
+)
+
+func testToken(sub string, exp time.Time) string {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf(`{"sub":"%v","exp":%v}`, sub, exp.Unix())))
+	return "eyJhbGciOiJSUzI1NiJ9." + payload + ".c2lnbmF0dXJl"
+}
+
+func TestClientsetCache_Get(t *testing.T) {
+	now := time.Now()
+	cache := NewClientsetCache(2)
+	cache.now = func() time.Time { return now }
+
+	created := 0
+	create := func() (*Clientsets, error) {
+		created++
+		return &Clientsets{}, nil
+	}
+
+	tokenA := testToken("a", now.Add(time.Hour))
+	tokenB := testToken("b", now.Add(time.Hour))
+	tokenC := testToken("c", now.Add(2*time.Hour))
+
+	first, err := cache.Get(tokenA, create)
+	assert.NoError(t, err)
+	second, err := cache.Get(tokenA, create)
+	assert.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, created)
+	assert.Equal(t, uint64(1), cache.Hits())
+	assert.Equal(t, uint64(1), cache.Misses())
+
+	// tokenA is the least recently used entry and gets evicted by tokenC
+	_, _ = cache.Get(tokenB, create)
+	_, _ = cache.Get(tokenC, create)
+	assert.Equal(t, 2, cache.Len())
+	_, _ = cache.Get(tokenA, create)
+	assert.Equal(t, 4, created)
+
+	// tokenC is still valid, the others expired
+	now = now.Add(90 * time.Minute)
+	_, _ = cache.Get(tokenC, create)
+	assert.Equal(t, 4, created)
+	_, _ = cache.Get(tokenA, create)
+	assert.Equal(t, 5, created)
+}
+
+func TestClientsetCache_SharedTransportConfig(t *testing.T) {
+	cache := NewClientsetCache(1)
+	config := &rest.Config{Host: "https://localhost:6443", TLSClientConfig: rest.TLSClientConfig{Insecure: true}}
+
+	first, err := cache.SharedTransportConfig(config)
+	assert.NoError(t, err)
+	second, err := cache.SharedTransportConfig(config)
+	assert.NoError(t, err)
+	assert.Equal(t, first.Transport, second.Transport)
+	assert.Empty(t, first.TLSClientConfig)
+	// the original config is untouched
+	assert.True(t, config.Insecure)
+}
+
+func TestTokenExpiry(t *testing.T) {
+	now := time.Now()
+	exp := now.Add(time.Hour).Truncate(time.Second)
+	tests := []struct {
+		name  string
+		token string
+		want  time.Time
+	}{
+		{
+			name:  "jwt with exp",
+			token: testToken("foo", exp),
+			want:  exp,
+		},
+		{
+			name:  "opaque token",
+			token: "foo",
+			want:  now.Add(DefaultTokenTTL),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(tokenExpiry(tt.token, now)))
+		})
+	}
+}
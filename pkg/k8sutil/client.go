@@ -1,12 +1,19 @@
 package k8sutil
 
 import (
+	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
 	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/pkg/apis/clientauthentication"
 	"k8s.io/client-go/rest"
@@ -34,18 +41,22 @@ func buildConfig() (*rest.Config, error) {
 	return config.ClientConfig()
 }
 
-func buildConfigFromToken(token string) (*rest.Config, error) {
+// buildClusterConfig returns the config of the cluster from the kubeconfig without any credentials
+func buildClusterConfig() (*rest.Config, error) {
 	restConfig, err := buildConfig()
 	if err != nil {
 		return nil, err
 	}
 
-	restConfig, err = rest.ExecClusterToConfig(&clientauthentication.Cluster{
+	return rest.ExecClusterToConfig(&clientauthentication.Cluster{
 		Server:                   restConfig.Host,
 		TLSServerName:            restConfig.ServerName,
 		CertificateAuthorityData: restConfig.CAData,
 	})
+}
 
+func buildConfigFromToken(token string) (*rest.Config, error) {
+	restConfig, err := buildClusterConfig()
 	if err != nil {
 		return nil, err
 	}
@@ -90,3 +101,212 @@ func NewChatClientsetFromToken(token string) (*chatv1alpha1.ChatV1alpha1Client,
 	// create the clientset
 	return chatv1alpha1.NewForConfig(c)
 }
+
+// ClientFactory creates the kubernetes clients used to serve a single request
+type ClientFactory interface {
+	// KubeClient returns a kubernetes clientset acting on behalf of the user of the request
+	KubeClient(ctx context.Context) (kubernetes.Interface, error)
+	// ChatClient returns a chat clientset acting on behalf of the user of the request
+	ChatClient(ctx context.Context) (chatv1alpha1.ChatV1alpha1Interface, error)
+	// CertManagerClient returns a cert-manager clientset acting on behalf of the user of the request
+	CertManagerClient(ctx context.Context) (certmanager.Interface, error)
+}
+
+type tokenClientFactory struct {
+	config *rest.Config
+	cache  *ClientsetCache
+}
+
+// NewTokenClientFactory returns a ClientFactory which creates clientsets
+// that authenticate with the bearer token of the request.
+// The clientsets are reused from the cache as long as the token is valid.
+func NewTokenClientFactory(cache *ClientsetCache) (ClientFactory, error) {
+	config, err := buildClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	return tokenClientFactory{
+		config: config,
+		cache:  cache,
+	}, nil
+}
+
+func (f tokenClientFactory) clientsets(ctx context.Context) (*Clientsets, error) {
+	token, err := oauth.GetAuthTokenFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting token: %w", err)
+	}
+	return f.cache.Get(token, func() (*Clientsets, error) {
+		config, err := f.cache.SharedTransportConfig(f.config)
+		if err != nil {
+			return nil, err
+		}
+		config.BearerToken = token
+		return newClientsets(config)
+	})
+}
+
+func (f tokenClientFactory) KubeClient(ctx context.Context) (kubernetes.Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.Kube, nil
+}
+
+func (f tokenClientFactory) ChatClient(ctx context.Context) (chatv1alpha1.ChatV1alpha1Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.Chat, nil
+}
+
+func (f tokenClientFactory) CertManagerClient(ctx context.Context) (certmanager.Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.CertManager, nil
+}
+
+// ImpersonationOptions configure how the verified claims of a user are mapped to the impersonated kubernetes user
+type ImpersonationOptions struct {
+	// UsernameClaim is the claim used as the kubernetes username, one of sub, email or preferred_username
+	UsernameClaim string
+	// UsernamePrefix is prepended to the username, matching the --oidc-username-prefix of the api server
+	UsernamePrefix string
+	// GroupsPrefix is prepended to every group, matching the --oidc-groups-prefix of the api server
+	GroupsPrefix string
+}
+
+// systemPrefix starts the reserved names of kubernetes users and groups, e.g. system:masters
+const systemPrefix = "system:"
+
+// Validate returns an error if users could be impersonated as reserved kubernetes users or groups.
+// The prefixes separate the users of the identity provider from the other users of the cluster, so they are required.
+func (o ImpersonationOptions) Validate() error {
+	if o.UsernamePrefix == "" || o.GroupsPrefix == "" {
+		return fmt.Errorf("Impersonation needs a username and a groups prefix")
+	}
+	if strings.HasPrefix(o.UsernamePrefix, systemPrefix) || strings.HasPrefix(o.GroupsPrefix, systemPrefix) {
+		return fmt.Errorf("Impersonation prefixes can't start with %v", systemPrefix)
+	}
+	return nil
+}
+
+type impersonationClientFactory struct {
+	config *rest.Config
+	cache  *ClientsetCache
+	opts   ImpersonationOptions
+}
+
+// NewImpersonationClientFactory returns a ClientFactory which creates clientsets
+// that authenticate with the credentials of the api-server from the kubeconfig
+// and impersonate the user and groups of the verified claims of the request.
+// Kubernetes RBAC authorizes the requests as the impersonated user.
+func NewImpersonationClientFactory(cache *ClientsetCache, opts ImpersonationOptions) (ClientFactory, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	config, err := buildConfig()
+	if err != nil {
+		return nil, err
+	}
+	return impersonationClientFactory{
+		config: config,
+		cache:  cache,
+		opts:   opts,
+	}, nil
+}
+
+// impersonationConfig returns a copy of config impersonating the user of claims.
+// Reserved kubernetes users are rejected and reserved groups are dropped, they would get the rights of the cluster
+// instead of the rights of the user.
+func (f impersonationClientFactory) impersonationConfig(config *rest.Config, claims *oauth.Claims) (*rest.Config, error) {
+	username, err := claims.Username(f.opts.UsernameClaim)
+	if err != nil {
+		return nil, err
+	}
+	username = f.opts.UsernamePrefix + username
+	if strings.HasPrefix(username, systemPrefix) {
+		return nil, status.Errorf(codes.PermissionDenied, "User %v can't be impersonated", username)
+	}
+	groups := make([]string, 0, len(claims.Groups))
+	for _, group := range claims.Groups {
+		group = f.opts.GroupsPrefix + group
+		if strings.HasPrefix(group, systemPrefix) {
+			continue
+		}
+		groups = append(groups, group)
+	}
+	config = rest.CopyConfig(config)
+	config.Impersonate = rest.ImpersonationConfig{
+		UserName: username,
+		Groups:   groups,
+	}
+	return config, nil
+}
+
+func (f impersonationClientFactory) clientsets(ctx context.Context) (*Clientsets, error) {
+	token, err := oauth.GetAuthTokenFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting token: %w", err)
+	}
+	claims, err := oauth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the claims of a token never change, so the token is still used as cache key
+	return f.cache.Get(token, func() (*Clientsets, error) {
+		config, err := f.cache.SharedTransportConfig(f.config)
+		if err != nil {
+			return nil, err
+		}
+		config, err = f.impersonationConfig(config, claims)
+		if err != nil {
+			return nil, err
+		}
+		return newClientsets(config)
+	})
+}
+
+func (f impersonationClientFactory) KubeClient(ctx context.Context) (kubernetes.Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.Kube, nil
+}
+
+func (f impersonationClientFactory) ChatClient(ctx context.Context) (chatv1alpha1.ChatV1alpha1Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.Chat, nil
+}
+
+func (f impersonationClientFactory) CertManagerClient(ctx context.Context) (certmanager.Interface, error) {
+	clientsets, err := f.clientsets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return clientsets.CertManager, nil
+}
+
+func newClientsets(config *rest.Config) (*Clientsets, error) {
+	kube, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	chat, err := chatv1alpha1.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	certManager, err := certmanager.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Clientsets{Kube: kube, Chat: chat, CertManager: certManager}, nil
+}
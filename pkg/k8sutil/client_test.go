@@ -0,0 +1,110 @@
+package k8sutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/client-go/rest"
+)
+
+func TestImpersonationClientFactory_impersonationConfig(t *testing.T) {
+	serverConfig := &rest.Config{Host: "https://localhost:6443", BearerToken: "server-token"}
+	claims := &oauth.Claims{
+		Subject:           "6f1c1d9e",
+		PreferredUsername: "foo",
+		Groups:            []string{"tenants", "admins"},
+	}
+	system := &oauth.Claims{
+		PreferredUsername: "foo",
+		Groups:            []string{"system:masters", "tenants"},
+	}
+	tests := []struct {
+		name       string
+		opts       ImpersonationOptions
+		claims     *oauth.Claims
+		wantUser   string
+		wantGroups []string
+		wantErr    bool
+	}{
+		{
+			name:       "preferred username with prefixes",
+			opts:       ImpersonationOptions{UsernameClaim: "preferred_username", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"},
+			claims:     claims,
+			wantUser:   "oidc:foo",
+			wantGroups: []string{"oidc:tenants", "oidc:admins"},
+		},
+		{
+			name:       "subject",
+			opts:       ImpersonationOptions{UsernameClaim: "sub", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"},
+			claims:     claims,
+			wantUser:   "oidc:6f1c1d9e",
+			wantGroups: []string{"oidc:tenants", "oidc:admins"},
+		},
+		{
+			name:       "system groups are dropped",
+			opts:       ImpersonationOptions{UsernameClaim: "preferred_username", UsernamePrefix: "oidc:", GroupsPrefix: ""},
+			claims:     system,
+			wantUser:   "oidc:foo",
+			wantGroups: []string{"tenants"},
+		},
+		{
+			name:    "system user",
+			opts:    ImpersonationOptions{UsernameClaim: "preferred_username", UsernamePrefix: "system:"},
+			claims:  system,
+			wantErr: true,
+		},
+		{
+			name:    "missing claim",
+			opts:    ImpersonationOptions{UsernameClaim: "email", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"},
+			claims:  claims,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := impersonationClientFactory{config: serverConfig, opts: tt.opts}
+			config, err := f.impersonationConfig(serverConfig, tt.claims)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantUser, config.Impersonate.UserName)
+			assert.Equal(t, tt.wantGroups, config.Impersonate.Groups)
+			// the api-server keeps authenticating with its own credentials
+			assert.Equal(t, "server-token", config.BearerToken)
+			assert.Empty(t, serverConfig.Impersonate.UserName)
+		})
+	}
+}
+
+func TestImpersonationOptions_Validate(t *testing.T) {
+	assert.NoError(t, ImpersonationOptions{UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"}.Validate())
+	assert.Error(t, ImpersonationOptions{GroupsPrefix: "oidc:"}.Validate())
+	assert.Error(t, ImpersonationOptions{UsernamePrefix: "oidc:"}.Validate())
+	assert.Error(t, ImpersonationOptions{UsernamePrefix: "system:", GroupsPrefix: "oidc:"}.Validate())
+}
+
+func TestImpersonationClientFactory_clientsets(t *testing.T) {
+	cache := NewClientsetCache(1)
+	f := impersonationClientFactory{
+		config: &rest.Config{Host: "https://localhost:6443", BearerToken: "server-token"},
+		cache:  cache,
+		opts:   ImpersonationOptions{UsernameClaim: "preferred_username", UsernamePrefix: "oidc:", GroupsPrefix: "oidc:"},
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer user-token"))
+	_, err := f.KubeClient(ctx)
+	assert.Error(t, err, "requests without verified claims must be rejected")
+
+	ctx = context.WithValue(ctx, oauth.ClaimsKey, &oauth.Claims{PreferredUsername: "foo"})
+	_, err = f.KubeClient(ctx)
+	assert.NoError(t, err)
+	_, err = f.ChatClient(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), cache.Misses())
+	assert.Equal(t, uint64(1), cache.Hits())
+}
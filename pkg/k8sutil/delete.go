@@ -8,23 +8,27 @@ import (
 	"k8s.io/client-go/kubernetes"
 )
 
-func DeleteVolumeClaim(ctx context.Context, rocket *chatv1alpha1.Rocket, namespace string, kubeclient kubernetes.Interface) error {
+// DeleteVolumeClaim deletes the PersistentVolumeClaims mounted by the pods of the rocket with opts
+// and returns the names of the deleted claims. With DryRun set in opts, the claims are only reported.
+func DeleteVolumeClaim(ctx context.Context, rocket *chatv1alpha1.Rocket, namespace string, kubeclient kubernetes.Interface, opts metav1.DeleteOptions) ([]string, error) {
+	var claims []string
 	// get pods from status
 	coreClient := kubeclient.CoreV1()
 	for _, pod := range rocket.Status.Pods {
 		pod, err := coreClient.Pods(namespace).Get(ctx, pod.Name, metav1.GetOptions{})
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, volume := range pod.Spec.Volumes {
 			if volume.PersistentVolumeClaim != nil {
 				claimName := volume.PersistentVolumeClaim.ClaimName
-				err = coreClient.PersistentVolumeClaims(namespace).Delete(ctx, claimName, metav1.DeleteOptions{})
+				err = coreClient.PersistentVolumeClaims(namespace).Delete(ctx, claimName, opts)
 				if err != nil {
-					return err
+					return nil, err
 				}
+				claims = append(claims, claimName)
 			}
 		}
 	}
-	return nil
+	return claims, nil
 }
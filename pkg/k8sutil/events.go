@@ -0,0 +1,132 @@
+package k8sutil
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// eventKey identifies the events that are merged, e.g. the failed image pulls of every retry of a pod
+type eventKey struct {
+	kind, name, eventType, reason, message string
+}
+
+// mergedEvent is an event whose count and timestamps span all merged events
+type mergedEvent struct {
+	event corev1.Event
+	// counts are the counts of the merged events by their uid, an event is updated by the cluster when it recurs
+	counts map[types.UID]int32
+}
+
+// EventAggregator merges the events of an object with the same type, reason and message.
+// The cluster only merges recurring events for a limited time and reports them again once they were garbage collected.
+type EventAggregator struct {
+	events map[eventKey]*mergedEvent
+}
+
+func NewEventAggregator() *EventAggregator {
+	return &EventAggregator{events: make(map[eventKey]*mergedEvent)}
+}
+
+// Add merges event into the events with the same key and returns the merged event.
+// changed reports if the count or the last timestamp of the merged event changed,
+// it is false for events that were already added.
+func (a *EventAggregator) Add(event *corev1.Event) (merged *corev1.Event, changed bool) {
+	key := eventKey{
+		kind:      event.InvolvedObject.Kind,
+		name:      event.InvolvedObject.Name,
+		eventType: event.Type,
+		reason:    event.Reason,
+		message:   event.Message,
+	}
+	count := event.Count
+	if event.Series != nil && event.Series.Count > count {
+		count = event.Series.Count
+	}
+	if count < 1 {
+		count = 1
+	}
+	first := metav1.NewTime(EventFirstTimestamp(event))
+	last := metav1.NewTime(EventLastTimestamp(event))
+
+	m, ok := a.events[key]
+	if !ok {
+		m = &mergedEvent{event: *event.DeepCopy(), counts: make(map[types.UID]int32)}
+		m.event.Count = 0
+		m.event.FirstTimestamp = first
+		m.event.LastTimestamp = last
+		a.events[key] = m
+		changed = true
+	}
+	if prev, ok := m.counts[event.UID]; !ok || count > prev {
+		m.event.Count += count - prev
+		m.counts[event.UID] = count
+		changed = true
+	}
+	if first.Before(&m.event.FirstTimestamp) {
+		m.event.FirstTimestamp = first
+	}
+	if m.event.LastTimestamp.Before(&last) {
+		m.event.LastTimestamp = last
+		m.event.Source = event.Source
+		changed = true
+	}
+	return m.event.DeepCopy(), changed
+}
+
+// Events returns the merged events sorted by their last timestamp
+func (a *EventAggregator) Events() []corev1.Event {
+	events := make([]corev1.Event, 0, len(a.events))
+	for _, m := range a.events {
+		events = append(events, *m.event.DeepCopy())
+	}
+	SortEvents(events)
+	return events
+}
+
+// SortEvents sorts the events by their last timestamp, events of the same time are sorted by object and reason
+func SortEvents(events []corev1.Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := &events[i], &events[j]
+		if ta, tb := EventLastTimestamp(a), EventLastTimestamp(b); !ta.Equal(tb) {
+			return ta.Before(tb)
+		}
+		if a.InvolvedObject.Kind != b.InvolvedObject.Kind {
+			return a.InvolvedObject.Kind < b.InvolvedObject.Kind
+		}
+		if a.InvolvedObject.Name != b.InvolvedObject.Name {
+			return a.InvolvedObject.Name < b.InvolvedObject.Name
+		}
+		return a.Reason < b.Reason
+	})
+}
+
+// EventLastTimestamp returns the time the event occurred last.
+// Events reported through the events.k8s.io api have no lastTimestamp, but an eventTime and a series.
+func EventLastTimestamp(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case event.Series != nil && !event.Series.LastObservedTime.IsZero():
+		return event.Series.LastObservedTime.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+// EventFirstTimestamp returns the time the event occurred first
+func EventFirstTimestamp(event *corev1.Event) time.Time {
+	switch {
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
@@ -0,0 +1,63 @@
+package k8sutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func testEvent(uid, pod, reason string, count int32, first, last time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{UID: types.UID(uid)},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: pod},
+		Type:           corev1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " of " + pod,
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(first),
+		LastTimestamp:  metav1.NewTime(last),
+	}
+}
+
+func TestEventAggregator(t *testing.T) {
+	t0 := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	a := NewEventAggregator()
+
+	merged, changed := a.Add(testEvent("1", "foo-mongodb-0", "FailedScheduling", 3, t0, t0.Add(time.Minute)))
+	assert.True(t, changed)
+	assert.Equal(t, int32(3), merged.Count)
+
+	// the same event again doesn't change anything
+	_, changed = a.Add(testEvent("1", "foo-mongodb-0", "FailedScheduling", 3, t0, t0.Add(time.Minute)))
+	assert.False(t, changed)
+
+	// a recurrence updates the count of the event
+	merged, changed = a.Add(testEvent("1", "foo-mongodb-0", "FailedScheduling", 5, t0, t0.Add(2*time.Minute)))
+	assert.True(t, changed)
+	assert.Equal(t, int32(5), merged.Count)
+
+	// an event reported again after garbage collection is merged
+	merged, changed = a.Add(testEvent("2", "foo-mongodb-0", "FailedScheduling", 2, t0.Add(time.Hour), t0.Add(2*time.Hour)))
+	assert.True(t, changed)
+	assert.Equal(t, int32(7), merged.Count)
+	assert.True(t, t0.Equal(merged.FirstTimestamp.Time))
+	assert.True(t, t0.Add(2*time.Hour).Equal(merged.LastTimestamp.Time))
+
+	a.Add(testEvent("3", "foo-rocketchat-6d4cf56db6-7xkq2", "BackOff", 1, t0.Add(time.Minute), t0.Add(time.Minute)))
+	// events without lastTimestamp are sorted by their eventTime
+	series := testEvent("4", "foo-rocketchat-6d4cf56db6-7xkq2", "Pulled", 0, time.Time{}, time.Time{})
+	series.EventTime = metav1.NewMicroTime(t0.Add(30 * time.Minute))
+	a.Add(series)
+
+	events := a.Events()
+	var reasons []string
+	for _, event := range events {
+		reasons = append(reasons, event.Reason)
+	}
+	assert.Equal(t, []string{"BackOff", "Pulled", "FailedScheduling"}, reasons)
+	assert.Equal(t, int32(1), events[1].Count)
+}
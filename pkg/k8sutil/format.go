@@ -2,11 +2,156 @@ package k8sutil
 
 import (
 	"github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
 )
 
+// IngressClassAnnotation sets the class of the ingress of a rocket.
+// The operator copies the annotations of the ingress spec of a rocket to its ingress, but doesn't set spec.ingressClassName.
+const IngressClassAnnotation = "kubernetes.io/ingress.class"
+
 func GetPodNamesFromRocket(rocket *v1alpha1.Rocket) (podNames []string) {
 	for _, pod := range rocket.Status.Pods {
 		podNames = append(podNames, pod.Name)
 	}
 	return
 }
+
+// RocketToResponse converts the rocket to the representation returned by the api
+func RocketToResponse(rocket *v1alpha1.Rocket) *rocketpb.GetResponse {
+	resp := &rocketpb.GetResponse{
+		Status:           rocket.Status.Message,
+		Phase:            string(rocket.Status.Phase),
+		WebserverVersion: rocket.Spec.Version,
+		MongodbVersion:   rocket.Spec.Database.Version,
+		Pods:             GetPodNamesFromRocket(rocket),
+		Name:             rocket.Name,
+		Namespace:        rocket.Namespace,
+		Host:             rocket.Spec.IngressSpec.Host,
+		Replicas:         rocket.Spec.Replicas,
+		IngressClass:     rocket.Spec.IngressSpec.Annotations[IngressClassAnnotation],
+		Metadata: &rocketpb.ObjectMeta{
+			Uid:               string(rocket.UID),
+			ResourceVersion:   rocket.ResourceVersion,
+			Generation:        rocket.Generation,
+			CreationTimestamp: toTimestamp(&rocket.CreationTimestamp),
+			DeletionTimestamp: toTimestamp(rocket.DeletionTimestamp),
+			Labels:            rocket.Labels,
+			Annotations:       rocket.Annotations,
+		},
+	}
+
+	// get databasesize if exists
+	storageSpec := rocket.Spec.Database.StorageSpec
+	if storageSpec != nil {
+		resp.DatabaseSize = storageSpec.Status.Capacity.Storage().String()
+	}
+	return resp
+}
+
+// EventToResponse converts the event to the representation returned by the api
+func EventToResponse(event *corev1.Event) *rocketpb.Event {
+	source := event.Source.Component
+	if source == "" {
+		source = event.ReportingController
+	}
+	first := metav1.NewTime(EventFirstTimestamp(event))
+	last := metav1.NewTime(EventLastTimestamp(event))
+	return &rocketpb.Event{
+		Type:           event.Type,
+		Reason:         event.Reason,
+		Message:        event.Message,
+		ObjectKind:     event.InvolvedObject.Kind,
+		ObjectName:     event.InvolvedObject.Name,
+		Count:          event.Count,
+		FirstTimestamp: toTimestamp(&first),
+		LastTimestamp:  toTimestamp(&last),
+		Source:         source,
+	}
+}
+
+// IssuerToResponse converts the issuer to the representation returned by the api.
+// Issuers of other kinds than ACME, self-signed and CA are returned without mode.
+func IssuerToResponse(issuer *cmapi.Issuer) *rocketpb.Issuer {
+	resp := &rocketpb.Issuer{
+		Name:      issuer.Name,
+		Namespace: issuer.Namespace,
+	}
+	config := issuer.Spec.IssuerConfig
+	switch {
+	case config.ACME != nil:
+		acme := &rocketpb.AcmeIssuer{
+			Server: config.ACME.Server,
+			Email:  config.ACME.Email,
+		}
+		for _, solver := range config.ACME.Solvers {
+			if solver.HTTP01 != nil && solver.HTTP01.Ingress != nil && solver.HTTP01.Ingress.Class != nil {
+				acme.IngressClass = *solver.HTTP01.Ingress.Class
+				break
+			}
+		}
+		resp.Mode = &rocketpb.Issuer_Acme{Acme: acme}
+	case config.SelfSigned != nil:
+		resp.Mode = &rocketpb.Issuer_SelfSigned{SelfSigned: &rocketpb.SelfSignedIssuer{}}
+	case config.CA != nil:
+		resp.Mode = &rocketpb.Issuer_Ca{Ca: &rocketpb.CaIssuer{SecretName: config.CA.SecretName}}
+	}
+	for _, condition := range issuer.Status.Conditions {
+		if condition.Type == cmapi.IssuerConditionReady {
+			resp.Ready = condition.Status == cmmeta.ConditionTrue
+			resp.Message = condition.Message
+		}
+	}
+	return resp
+}
+
+// CertificateToResponse converts the certificate to the representation returned by the api,
+// failureReason is why its last issuance failed
+func CertificateToResponse(certificate *cmapi.Certificate, failureReason string) *rocketpb.CertificateResponse {
+	resp := &rocketpb.CertificateResponse{
+		Name:            certificate.Name,
+		SecretName:      certificate.Spec.SecretName,
+		DnsNames:        certificate.Spec.DNSNames,
+		Issuer:          certificate.Spec.IssuerRef.Name,
+		NotBefore:       toTimestamp(certificate.Status.NotBefore),
+		NotAfter:        toTimestamp(certificate.Status.NotAfter),
+		RenewalTime:     toTimestamp(certificate.Status.RenewalTime),
+		FailureReason:   failureReason,
+		LastFailureTime: toTimestamp(certificate.Status.LastFailureTime),
+	}
+	for _, condition := range certificate.Status.Conditions {
+		if condition.Type == cmapi.CertificateConditionReady {
+			resp.Ready = condition.Status == cmmeta.ConditionTrue
+			resp.Message = condition.Message
+		}
+	}
+	return resp
+}
+
+// IngressClassToResponse converts the ingress class to the representation returned by the api
+func IngressClassToResponse(class *networkingv1.IngressClass) *rocketpb.IngressClass {
+	return &rocketpb.IngressClass{
+		Name:       class.Name,
+		Controller: class.Spec.Controller,
+		IsDefault:  IsDefaultIngressClass(class),
+	}
+}
+
+// IsDefaultIngressClass reports if class is the default ingress class of the cluster
+func IsDefaultIngressClass(class *networkingv1.IngressClass) bool {
+	return class.Annotations[networkingv1.AnnotationIsDefaultIngressClass] == "true"
+}
+
+// toTimestamp converts t to a protobuf timestamp, unset times are returned as nil
+func toTimestamp(t *metav1.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t.Time)
+}
@@ -0,0 +1,161 @@
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	chatv1alpha1Client "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+// hostIndex indexes the rockets and ingresses by their hosts
+const hostIndex = "host"
+
+// broadcastQueueLength is the amount of events buffered for every watch of the cache.
+// Events for watchers that don't keep up are dropped.
+const broadcastQueueLength = 100
+
+// RocketCache keeps the rockets of all namespaces in memory.
+// The informer of the cache uses the credentials of the api-server, users have to be authorized by the caller.
+// Pods aren't cached: the reads served from the cache only need the pods listed in the status of the rockets,
+// and logs are read from the kubelets with the clients of the users, so an informer would keep every pod
+// of the cluster in memory without serving any request.
+type RocketCache struct {
+	rockets cache.SharedIndexInformer
+
+	rocketEvents *watch.Broadcaster
+}
+
+// NewRocketCache returns a cache of the rockets listed with chatclient, that is filled by Run
+func NewRocketCache(chatclient chatv1alpha1Client.ChatV1alpha1Interface, resync time.Duration) *RocketCache {
+	indexers := cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		hostIndex:            rocketHostIndexFunc,
+	}
+	c := &RocketCache{
+		rockets: cache.NewSharedIndexInformer(&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return chatclient.Rockets(metav1.NamespaceAll).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return chatclient.Rockets(metav1.NamespaceAll).Watch(context.Background(), opts)
+			},
+		}, &chatv1alpha1.Rocket{}, resync, indexers),
+		rocketEvents: watch.NewBroadcaster(broadcastQueueLength, watch.DropIfChannelFull),
+	}
+	c.rockets.AddEventHandler(broadcastHandler(c.rocketEvents))
+	return c
+}
+
+func rocketHostIndexFunc(obj interface{}) ([]string, error) {
+	rocket, ok := obj.(*chatv1alpha1.Rocket)
+	if !ok || rocket.Spec.IngressSpec.Host == "" {
+		return nil, nil
+	}
+	return []string{normalizeHost(rocket.Spec.IngressSpec.Host)}, nil
+}
+
+// normalizeHost returns the host in lower case without the trailing dot of fully qualified names
+func normalizeHost(host string) string {
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// broadcastHandler passes the changes of an informer to the watchers of broadcaster
+func broadcastHandler(broadcaster *watch.Broadcaster) cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			broadcaster.ActionOrDrop(watch.Added, obj.(runtime.Object))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			broadcaster.ActionOrDrop(watch.Modified, obj.(runtime.Object))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if o, ok := obj.(runtime.Object); ok {
+				broadcaster.ActionOrDrop(watch.Deleted, o)
+			}
+		},
+	}
+}
+
+// Run starts the informer of the cache and waits until it is synced.
+// The informer is stopped when ctx is done, watches of the cache stay open and don't receive events anymore.
+func (c *RocketCache) Run(ctx context.Context) error {
+	go c.rockets.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.rockets.HasSynced) {
+		return fmt.Errorf("Error syncing the rocket cache")
+	}
+	return nil
+}
+
+// GetRocket returns a copy of the cached rocket or a NotFound error
+func (c *RocketCache) GetRocket(namespace, name string) (*chatv1alpha1.Rocket, error) {
+	obj, exists, err := c.rockets.GetIndexer().GetByKey(namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, apiErrors.NewNotFound(chatv1alpha1.SchemeGroupVersion.WithResource("rockets").GroupResource(), name)
+	}
+	return obj.(*chatv1alpha1.Rocket).DeepCopy(), nil
+}
+
+// ListRockets returns copies of the cached rockets in namespace matching selector, sorted by namespace and name.
+// Rockets of all namespaces are returned if namespace is empty.
+func (c *RocketCache) ListRockets(namespace string, selector labels.Selector) ([]chatv1alpha1.Rocket, error) {
+	objs, err := c.list(c.rockets, namespace)
+	if err != nil {
+		return nil, err
+	}
+	var rockets []chatv1alpha1.Rocket
+	for _, obj := range objs {
+		rocket := obj.(*chatv1alpha1.Rocket)
+		if selector.Matches(labels.Set(rocket.Labels)) {
+			rockets = append(rockets, *rocket.DeepCopy())
+		}
+	}
+	sort.Slice(rockets, func(i, j int) bool {
+		if rockets[i].Namespace != rockets[j].Namespace {
+			return rockets[i].Namespace < rockets[j].Namespace
+		}
+		return rockets[i].Name < rockets[j].Name
+	})
+	return rockets, nil
+}
+
+// RocketsByHost returns copies of the cached rockets of all namespaces using host, ignoring its case
+func (c *RocketCache) RocketsByHost(host string) ([]chatv1alpha1.Rocket, error) {
+	objs, err := c.rockets.GetIndexer().ByIndex(hostIndex, normalizeHost(host))
+	if err != nil {
+		return nil, err
+	}
+	rockets := make([]chatv1alpha1.Rocket, 0, len(objs))
+	for _, obj := range objs {
+		rockets = append(rockets, *obj.(*chatv1alpha1.Rocket).DeepCopy())
+	}
+	return rockets, nil
+}
+
+func (c *RocketCache) list(informer cache.SharedIndexInformer, namespace string) ([]interface{}, error) {
+	if namespace == metav1.NamespaceAll {
+		return informer.GetIndexer().List(), nil
+	}
+	return informer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+}
+
+// WatchRockets returns a watch of the changes of all cached rockets.
+// The objects of the events are shared with the cache and must not be modified.
+func (c *RocketCache) WatchRockets() watch.Interface {
+	return c.rocketEvents.Watch()
+}
@@ -0,0 +1,74 @@
+package k8sutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	fakeChat "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func cachedRocket(name, namespace string, labels map[string]string) *chatv1alpha1.Rocket {
+	return &chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels}}
+}
+
+func TestRocketCache(t *testing.T) {
+	chatclient := fakeChat.NewSimpleClientset(
+		cachedRocket("foo", "a", map[string]string{"team": "x"}),
+		cachedRocket("bar", "a", nil),
+		cachedRocket("baz", "b", map[string]string{"team": "x"}),
+	).ChatV1alpha1()
+
+	c := NewRocketCache(chatclient, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, c.Run(ctx))
+
+	rocket, err := c.GetRocket("a", "foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", rocket.Name)
+	_, err = c.GetRocket("b", "foo")
+	assert.True(t, apiErrors.IsNotFound(err))
+
+	rockets, err := c.ListRockets("a", labels.Everything())
+	assert.NoError(t, err)
+	assert.Len(t, rockets, 2)
+	assert.Equal(t, "bar", rockets[0].Name)
+	rockets, err = c.ListRockets("", labels.SelectorFromSet(labels.Set{"team": "x"}))
+	assert.NoError(t, err)
+	assert.Len(t, rockets, 2)
+	assert.Equal(t, "b", rockets[1].Namespace)
+
+	hosted := cachedRocket("chat", "c", nil)
+	hosted.Spec.IngressSpec.Host = "Chat.Example.com"
+	_, err = chatclient.Rockets("c").Create(ctx, hosted, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		rockets, err := c.RocketsByHost("chat.example.com.")
+		return err == nil && len(rockets) == 1 && rockets[0].Name == "chat"
+	}, 5*time.Second, 10*time.Millisecond, "rockets are indexed by their host")
+	rockets, err = c.RocketsByHost("other.example.com")
+	assert.NoError(t, err)
+	assert.Empty(t, rockets)
+
+	// changes of the rockets are passed to the watches of the cache
+	w := c.WatchRockets()
+	defer w.Stop()
+	_, err = chatclient.Rockets("b").Create(ctx, cachedRocket("new", "b", nil), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	select {
+	case event := <-w.ResultChan():
+		assert.Equal(t, watch.Added, event.Type)
+		assert.Equal(t, "new", event.Object.(*chatv1alpha1.Rocket).Name)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event")
+	}
+	_, err = c.GetRocket("b", "new")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,73 @@
+package k8sutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// IngressCache keeps the ingresses of all namespaces in memory, indexed by the hosts of their rules.
+// The informer of the cache uses the credentials of the api-server.
+type IngressCache struct {
+	ingresses cache.SharedIndexInformer
+}
+
+// NewIngressCache returns a cache of the ingresses listed with kubeclient, that is filled by Run
+func NewIngressCache(kubeclient kubernetes.Interface, resync time.Duration) *IngressCache {
+	return &IngressCache{
+		ingresses: cache.NewSharedIndexInformer(&cache.ListWatch{
+			ListFunc: func(opts metav1.ListOptions) (runtime.Object, error) {
+				return kubeclient.NetworkingV1().Ingresses(metav1.NamespaceAll).List(context.Background(), opts)
+			},
+			WatchFunc: func(opts metav1.ListOptions) (watch.Interface, error) {
+				return kubeclient.NetworkingV1().Ingresses(metav1.NamespaceAll).Watch(context.Background(), opts)
+			},
+		}, &networkingv1.Ingress{}, resync, cache.Indexers{hostIndex: ingressHostIndexFunc}),
+	}
+}
+
+func ingressHostIndexFunc(obj interface{}) ([]string, error) {
+	ingress, ok := obj.(*networkingv1.Ingress)
+	if !ok {
+		return nil, nil
+	}
+	var hosts []string
+	seen := make(map[string]bool)
+	for _, rule := range ingress.Spec.Rules {
+		host := normalizeHost(rule.Host)
+		if host != "" && !seen[host] {
+			seen[host] = true
+			hosts = append(hosts, host)
+		}
+	}
+	return hosts, nil
+}
+
+// Run starts the informer of the cache and waits until it is synced, the informer is stopped when ctx is done
+func (c *IngressCache) Run(ctx context.Context) error {
+	go c.ingresses.Run(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), c.ingresses.HasSynced) {
+		return fmt.Errorf("Error syncing the ingress cache")
+	}
+	return nil
+}
+
+// IngressesByHost returns copies of the cached ingresses of all namespaces with a rule for host, ignoring its case
+func (c *IngressCache) IngressesByHost(host string) ([]networkingv1.Ingress, error) {
+	objs, err := c.ingresses.GetIndexer().ByIndex(hostIndex, normalizeHost(host))
+	if err != nil {
+		return nil, err
+	}
+	ingresses := make([]networkingv1.Ingress, 0, len(objs))
+	for _, obj := range objs {
+		ingresses = append(ingresses, *obj.(*networkingv1.Ingress).DeepCopy())
+	}
+	return ingresses, nil
+}
@@ -0,0 +1,47 @@
+package k8sutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func hostIngress(name, namespace string, hosts ...string) *networkingv1.Ingress {
+	ingress := &networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	for _, host := range hosts {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, networkingv1.IngressRule{Host: host})
+	}
+	return ingress
+}
+
+func TestIngressCache(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset(
+		hostIngress("shop", "a", "Shop.example.com", "shop.example.com"),
+		hostIngress("default", "b"),
+	)
+	c := NewIngressCache(kubeclient, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.NoError(t, c.Run(ctx))
+
+	ingresses, err := c.IngressesByHost("shop.example.com.")
+	assert.NoError(t, err)
+	if assert.Len(t, ingresses, 1) {
+		assert.Equal(t, "shop", ingresses[0].Name)
+	}
+	ingresses, err = c.IngressesByHost("")
+	assert.NoError(t, err)
+	assert.Empty(t, ingresses, "ingresses without host are not indexed")
+
+	_, err = kubeclient.NetworkingV1().Ingresses("b").Create(ctx, hostIngress("blog", "b", "blog.example.com"), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	assert.Eventually(t, func() bool {
+		ingresses, err := c.IngressesByHost("blog.example.com")
+		return err == nil && len(ingresses) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
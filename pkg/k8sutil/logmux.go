@@ -0,0 +1,363 @@
+package k8sutil
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DropPolicy decides what happens to the lines of a pod whose buffer is full
+type DropPolicy int
+
+const (
+	// Block stops reading from the pod until the buffered lines are sent
+	Block DropPolicy = iota
+	// DropOldest discards the oldest buffered line of the pod to make room for the new one
+	DropOldest
+	// DropNewest discards new lines of the pod until the buffered lines are sent
+	DropNewest
+)
+
+// LogEvent marks lines that aren't logged by a pod, but report a change of the logs
+type LogEvent int
+
+const (
+	// LogEventNone is set for the lines of the pods
+	LogEventNone LogEvent = iota
+	// LogEventJoined marks the start of the logs of a pod added with Join
+	LogEventJoined
+	// LogEventStopped marks the end of the logs of a pod, if enabled by the options
+	LogEventStopped
+)
+
+// DefaultLogBufferSize is the amount of lines buffered per pod if no size is configured
+const DefaultLogBufferSize = 1000
+
+// LogLine is a single line of the logs of a pod
+type LogLine struct {
+	Pod string
+	// Timestamp is the time the line was logged, if the logs were requested with timestamps
+	Timestamp time.Time
+	Message   string
+	// Dropped is the amount of lines of the pod that were dropped before this line
+	Dropped int
+	// Event is set for lines without message that mark a change of the logs
+	Event LogEvent
+}
+
+// LogMuxOptions configure the buffering and ordering of a LogMux
+type LogMuxOptions struct {
+	// BufferSize is the amount of lines buffered per pod, DefaultLogBufferSize if 0
+	BufferSize int
+	// DropPolicy is applied to the lines of pods whose buffer is full
+	DropPolicy DropPolicy
+	// Timestamps is set if the logs were requested with timestamps.
+	// The timestamp is removed from the message and set as Timestamp of the line.
+	Timestamps bool
+	// MergeWindow orders the lines of all pods by their timestamp if Timestamps are set.
+	// Lines are held back until every pod has a line buffered or the window elapsed,
+	// so pods that are quiet delay the others by at most the window.
+	MergeWindow time.Duration
+	// MarkStopped adds a line with LogEventStopped after the logs of every pod
+	MarkStopped bool
+}
+
+type bufferedLine struct {
+	LogLine
+	// received is when the line was read, used to enforce the merge window
+	received time.Time
+	// seq orders lines by the time they were read
+	seq uint64
+}
+
+type logSource struct {
+	pod     string
+	logs    io.ReadCloser
+	lines   []bufferedLine
+	dropped int
+	done    bool
+}
+
+// LogMux fans in the logs of several pods into a single stream.
+// Lines are read concurrently, but handed to the send function of Run one at a time,
+// because a grpc stream must not be sent to concurrently.
+type LogMux struct {
+	opts LogMuxOptions
+	now  func() time.Time
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	sources []*logSource
+	seq     uint64
+	closed  bool
+	stopped bool
+	err     error
+	// notify wakes up Run when lines are buffered or sources finish
+	notify chan struct{}
+}
+
+// NewLogMux returns a LogMux for the logs added with Add
+func NewLogMux(opts LogMuxOptions) *LogMux {
+	if opts.BufferSize <= 0 {
+		opts.BufferSize = DefaultLogBufferSize
+	}
+	m := &LogMux{
+		opts:   opts,
+		now:    time.Now,
+		notify: make(chan struct{}, 1),
+	}
+	m.cond = sync.NewCond(&m.mu)
+	return m
+}
+
+// Add reads the logs of pod from logs until they end or Run returns, logs is closed afterwards.
+func (m *LogMux) Add(pod string, logs io.ReadCloser) {
+	m.add(pod, logs, LogEventNone)
+}
+
+// Join adds the logs of a pod that appeared after the logs were started.
+// Its lines are preceded by a line with LogEventJoined.
+func (m *LogMux) Join(pod string, logs io.ReadCloser) {
+	m.add(pod, logs, LogEventJoined)
+}
+
+func (m *LogMux) add(pod string, logs io.ReadCloser, event LogEvent) {
+	source := &logSource{pod: pod, logs: logs}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		logs.Close()
+		return
+	}
+	if event != LogEventNone {
+		m.appendEvent(source, event)
+	}
+	m.sources = append(m.sources, source)
+	go m.read(source)
+}
+
+// appendEvent needs to be called with the lock held
+func (m *LogMux) appendEvent(source *logSource, event LogEvent) {
+	m.seq++
+	source.lines = append(source.lines, bufferedLine{
+		LogLine:  LogLine{Pod: source.pod, Dropped: source.dropped, Event: event},
+		received: m.now(),
+		seq:      m.seq,
+	})
+	source.dropped = 0
+}
+
+// Close marks that no more logs will be added, so Run returns once all added logs are sent
+func (m *LogMux) Close() {
+	m.mu.Lock()
+	m.closed = true
+	m.mu.Unlock()
+	m.wake()
+}
+
+func (m *LogMux) read(source *logSource) {
+	defer source.logs.Close()
+	reader := bufio.NewReader(source.logs)
+	for {
+		line, err := reader.ReadString('\n')
+		if line != "" && !m.push(source, strings.TrimSuffix(line, "\n")) {
+			return
+		}
+		if err != nil {
+			m.finish(source, err)
+			return
+		}
+	}
+}
+
+// push buffers a line of source and reports if reading should continue
+func (m *LogMux) push(source *logSource, message string) bool {
+	line := bufferedLine{LogLine: LogLine{Pod: source.pod, Message: message}, received: m.now()}
+	if m.opts.Timestamps {
+		line.Timestamp, line.Message = SplitTimestamp(message)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for len(source.lines) >= m.opts.BufferSize && m.opts.DropPolicy == Block && !m.stopped {
+		m.cond.Wait()
+	}
+	if m.stopped {
+		return false
+	}
+	if len(source.lines) >= m.opts.BufferSize {
+		if m.opts.DropPolicy == DropNewest {
+			source.dropped++
+			return true
+		}
+		// the next buffered line follows the gap
+		oldest := source.lines[0]
+		source.lines = source.lines[1:]
+		if len(source.lines) > 0 {
+			source.lines[0].Dropped += oldest.Dropped + 1
+		} else {
+			source.dropped += oldest.Dropped + 1
+		}
+	}
+	m.seq++
+	line.seq = m.seq
+	line.Dropped, source.dropped = source.dropped, 0
+	source.lines = append(source.lines, line)
+	m.wake()
+	return true
+}
+
+func (m *LogMux) finish(source *logSource, err error) {
+	m.mu.Lock()
+	source.done = true
+	switch {
+	case m.opts.MarkStopped:
+		m.appendEvent(source, LogEventStopped)
+	case source.dropped > 0:
+		// report the lines dropped at the end of the logs with an empty line
+		m.appendEvent(source, LogEventNone)
+	}
+	if err != io.EOF && m.err == nil {
+		m.err = fmt.Errorf("Error reading logs of pod %v: %w", source.pod, err)
+	}
+	m.mu.Unlock()
+	m.wake()
+}
+
+func (m *LogMux) wake() {
+	select {
+	case m.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Run passes the lines of all added logs to send, until all logs are sent after Close,
+// ctx is done, send fails or a log can't be read. Reading the logs is stopped on return.
+func (m *LogMux) Run(ctx context.Context, send func(LogLine) error) error {
+	defer m.stop()
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		line, wait, done, err := m.next()
+		if err != nil || done {
+			return err
+		}
+		if line != nil {
+			if err := send(*line); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var delay <-chan time.Time
+		if wait > 0 {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(wait)
+			delay = timer.C
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-m.notify:
+		case <-delay:
+		}
+	}
+}
+
+func (m *LogMux) stop() {
+	m.mu.Lock()
+	m.stopped = true
+	for _, source := range m.sources {
+		// unblocks reading followed logs
+		source.logs.Close()
+	}
+	m.mu.Unlock()
+	m.cond.Broadcast()
+}
+
+// next removes the next line to send from the buffers.
+// If no line can be sent yet, the time to wait for the merge window is returned.
+func (m *LogMux) next() (line *LogLine, wait time.Duration, done bool, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return nil, 0, false, m.err
+	}
+
+	var next *logSource
+	complete := true
+	allDone := true
+	active := m.sources[:0]
+	for _, source := range m.sources {
+		if len(source.lines) == 0 {
+			if !source.done {
+				complete = false
+				allDone = false
+				active = append(active, source)
+			}
+			// sources that are done and sent are forgotten, followed logs may come and go
+			continue
+		}
+		active = append(active, source)
+		allDone = false
+		if next == nil || m.before(&source.lines[0], &next.lines[0]) {
+			next = source
+		}
+	}
+	for i := len(active); i < len(m.sources); i++ {
+		m.sources[i] = nil
+	}
+	m.sources = active
+	if next == nil {
+		return nil, 0, allDone && m.closed, nil
+	}
+
+	head := next.lines[0]
+	if m.merging() && !complete {
+		// another pod may still log an earlier line
+		if wait := head.received.Add(m.opts.MergeWindow).Sub(m.now()); wait > 0 {
+			return nil, wait, false, nil
+		}
+	}
+	next.lines = next.lines[1:]
+	m.cond.Broadcast()
+	return &head.LogLine, 0, false, nil
+}
+
+func (m *LogMux) merging() bool {
+	return m.opts.Timestamps && m.opts.MergeWindow > 0
+}
+
+// before reports if line a is sent before line b
+func (m *LogMux) before(a, b *bufferedLine) bool {
+	if m.merging() && !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.Before(b.Timestamp)
+	}
+	return a.seq < b.seq
+}
+
+// SplitTimestamp splits the RFC3339 timestamp the kubelet prefixes lines with from the message.
+// Lines without a timestamp are returned unchanged with a zero time.
+func SplitTimestamp(line string) (time.Time, string) {
+	i := strings.IndexByte(line, ' ')
+	if i < 0 {
+		i = len(line)
+	}
+	timestamp, err := time.Parse(time.RFC3339Nano, line[:i])
+	if err != nil {
+		return time.Time{}, line
+	}
+	if i == len(line) {
+		return timestamp, ""
+	}
+	return timestamp, line[i+1:]
+}
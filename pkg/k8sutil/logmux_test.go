@@ -0,0 +1,274 @@
+package k8sutil
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeLogStream records the lines sent by a LogMux and fails if lines are sent concurrently
+type fakeLogStream struct {
+	mu      sync.Mutex
+	sending bool
+	lines   []LogLine
+	// sent receives the first lines after they were recorded
+	sent chan LogLine
+	// block delays every send until it receives
+	block chan struct{}
+}
+
+func newFakeLogStream() *fakeLogStream {
+	return &fakeLogStream{sent: make(chan LogLine, 100)}
+}
+
+func (s *fakeLogStream) Send(line LogLine) error {
+	s.mu.Lock()
+	if s.sending {
+		s.mu.Unlock()
+		return errors.New("concurrent send")
+	}
+	s.sending = true
+	s.mu.Unlock()
+
+	if s.block != nil {
+		<-s.block
+	}
+
+	s.mu.Lock()
+	s.sending = false
+	s.lines = append(s.lines, line)
+	s.mu.Unlock()
+	select {
+	case s.sent <- line:
+	default:
+	}
+	return nil
+}
+
+func (s *fakeLogStream) messages() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var messages []string
+	for _, line := range s.lines {
+		messages = append(messages, line.Pod+": "+line.Message)
+	}
+	return messages
+}
+
+func logsOf(lines ...string) io.ReadCloser {
+	return io.NopCloser(strings.NewReader(strings.Join(lines, "\n") + "\n"))
+}
+
+func TestLogMux_Run(t *testing.T) {
+	m := NewLogMux(LogMuxOptions{})
+	var pods []string
+	for _, pod := range []string{"a", "b", "c", "d"} {
+		var lines []string
+		for i := 0; i < 50; i++ {
+			lines = append(lines, "line")
+		}
+		m.Add(pod, logsOf(lines...))
+		pods = append(pods, pod)
+	}
+	m.Close()
+
+	stream := newFakeLogStream()
+	assert.NoError(t, m.Run(context.Background(), stream.Send))
+	assert.Len(t, stream.lines, 200)
+	perPod := map[string]int{}
+	for _, line := range stream.lines {
+		perPod[line.Pod]++
+	}
+	for _, pod := range pods {
+		assert.Equal(t, 50, perPod[pod])
+	}
+}
+
+func TestLogMux_Run_merge(t *testing.T) {
+	m := NewLogMux(LogMuxOptions{Timestamps: true, MergeWindow: time.Minute})
+	m.Add("a", logsOf(
+		"2021-12-01T10:00:00.000000001Z first",
+		"2021-12-01T10:00:02Z third",
+	))
+	m.Add("b", logsOf(
+		"2021-12-01T10:00:01Z second",
+		"2021-12-01T10:00:03Z fourth",
+		"no timestamp",
+	))
+	m.Close()
+
+	stream := newFakeLogStream()
+	assert.NoError(t, m.Run(context.Background(), stream.Send))
+	// the lines of a pod are never reordered
+	assert.Equal(t, []string{"a: first", "b: second", "a: third", "b: fourth", "b: no timestamp"}, stream.messages())
+	assert.Equal(t, time.Date(2021, 12, 1, 10, 0, 0, 1, time.UTC), stream.lines[0].Timestamp)
+}
+
+func TestLogMux_Run_mergeWindow(t *testing.T) {
+	m := NewLogMux(LogMuxOptions{Timestamps: true, MergeWindow: 50 * time.Millisecond})
+	quiet, _ := io.Pipe()
+	m.Add("quiet", quiet)
+	m.Add("a", logsOf("2021-12-01T10:00:00Z hello"))
+
+	stream := newFakeLogStream()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		_ = m.Run(ctx, stream.Send)
+	}()
+
+	// the line is held back for the quiet pod until the window elapsed
+	start := time.Now()
+	select {
+	case line := <-stream.sent:
+		assert.Equal(t, "hello", line.Message)
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for line")
+	}
+}
+
+// gatedReader returns its first line right away and the rest after the gate is closed
+type gatedReader struct {
+	first string
+	gate  chan struct{}
+	rest  io.Reader
+}
+
+func (r *gatedReader) Read(p []byte) (int, error) {
+	if r.first != "" {
+		n := copy(p, r.first)
+		r.first = r.first[n:]
+		return n, nil
+	}
+	<-r.gate
+	return r.rest.Read(p)
+}
+
+func (r *gatedReader) Close() error { return nil }
+
+func TestLogMux_Run_dropPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      DropPolicy
+		wantLines   []string
+		wantDropped []int
+	}{
+		{
+			name:        "block",
+			policy:      Block,
+			wantLines:   []string{"1", "2", "3", "4", "5", "6"},
+			wantDropped: []int{0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:        "drop oldest",
+			policy:      DropOldest,
+			wantLines:   []string{"1", "5", "6"},
+			wantDropped: []int{0, 3, 0},
+		},
+		{
+			name:        "drop newest",
+			policy:      DropNewest,
+			wantLines:   []string{"1", "2", "3", ""},
+			wantDropped: []int{0, 0, 0, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewLogMux(LogMuxOptions{BufferSize: 2, DropPolicy: tt.policy})
+			stream := newFakeLogStream()
+			stream.block = make(chan struct{})
+			logs := &gatedReader{first: "1\n", gate: make(chan struct{}), rest: strings.NewReader("2\n3\n4\n5\n6\n")}
+			m.Add("a", logs)
+			m.Close()
+			errs := make(chan error, 1)
+			go func() {
+				errs <- m.Run(context.Background(), stream.Send)
+			}()
+
+			// the other lines are read while the first line is being sent
+			assert.Eventually(t, func() bool {
+				stream.mu.Lock()
+				defer stream.mu.Unlock()
+				return stream.sending
+			}, 5*time.Second, time.Millisecond)
+			close(logs.gate)
+			source := m.sources[0]
+			if tt.policy == Block {
+				assert.Eventually(t, func() bool {
+					m.mu.Lock()
+					defer m.mu.Unlock()
+					return len(source.lines) == 2
+				}, 5*time.Second, time.Millisecond)
+				time.Sleep(20 * time.Millisecond)
+				m.mu.Lock()
+				assert.False(t, source.done, "lines must not be read while the buffer is full")
+				m.mu.Unlock()
+			} else {
+				assert.Eventually(t, func() bool {
+					m.mu.Lock()
+					defer m.mu.Unlock()
+					return source.done
+				}, 5*time.Second, time.Millisecond)
+			}
+			close(stream.block)
+
+			assert.NoError(t, <-errs)
+			var lines []string
+			var dropped []int
+			for _, line := range stream.lines {
+				lines = append(lines, line.Message)
+				dropped = append(dropped, line.Dropped)
+			}
+			assert.Equal(t, tt.wantLines, lines)
+			assert.Equal(t, tt.wantDropped, dropped)
+		})
+	}
+}
+
+func TestLogMux_Run_errors(t *testing.T) {
+	failing, writer := io.Pipe()
+	_ = writer.CloseWithError(errors.New("connection reset"))
+	m := NewLogMux(LogMuxOptions{})
+	m.Add("a", failing)
+	err := m.Run(context.Background(), newFakeLogStream().Send)
+	assert.EqualError(t, err, "Error reading logs of pod a: connection reset")
+
+	// followed logs are closed when the stream is canceled
+	followed, _ := io.Pipe()
+	m = NewLogMux(LogMuxOptions{})
+	m.Add("a", followed)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NoError(t, m.Run(ctx, newFakeLogStream().Send))
+	_, err = followed.Read(make([]byte, 1))
+	assert.ErrorIs(t, err, io.ErrClosedPipe)
+
+	m = NewLogMux(LogMuxOptions{})
+	m.Add("a", logsOf("line"))
+	err = m.Run(context.Background(), func(LogLine) error { return errors.New("send failed") })
+	assert.EqualError(t, err, "send failed")
+}
+
+func TestLogMux_Run_events(t *testing.T) {
+	m := NewLogMux(LogMuxOptions{MarkStopped: true})
+	m.Add("a", logsOf("hello"))
+	m.Join("b", logsOf("world"))
+	m.Close()
+
+	stream := newFakeLogStream()
+	assert.NoError(t, m.Run(context.Background(), stream.Send))
+	events := map[string][]LogEvent{}
+	for _, line := range stream.lines {
+		events[line.Pod] = append(events[line.Pod], line.Event)
+	}
+	assert.Equal(t, []LogEvent{LogEventNone, LogEventStopped}, events["a"])
+	assert.Equal(t, []LogEvent{LogEventJoined, LogEventNone, LogEventStopped}, events["b"])
+	assert.Empty(t, m.sources, "sent sources are forgotten")
+}
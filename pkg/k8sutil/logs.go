@@ -1,66 +1,105 @@
 package k8sutil
 
 import (
-	"bufio"
+	"archive/tar"
+	"compress/gzip"
+	"context"
 	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
-	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
-	"go.uber.org/zap"
 	corev1 "k8s.io/api/core/v1"
-	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
-// GetPodLogs retrieves the Logs of a Pod and writes it to a grpc stream
-func GetPodLogs(clientset kubernetes.Interface, podNames []string, namespace string, stream rocketpb.RocketService_LogsServer, errChan chan error) {
-	ctx := stream.Context()
-	logger := zap.S()
-	podLogOptions := corev1.PodLogOptions{
-		Follow: true,
-	}
+// AddPodLogs opens the logs of the pods and adds them to the mux.
+// If the logs of a pod can't be opened, none are added.
+func AddPodLogs(ctx context.Context, clientset kubernetes.Interface, namespace string, podNames []string, opts corev1.PodLogOptions, mux *LogMux) error {
 	podClient := clientset.CoreV1().Pods(namespace)
+	logs := make([]io.ReadCloser, 0, len(podNames))
 	for _, name := range podNames {
-		logger.Debugf("Starting log collection for pod: %v", name)
-		go func(podName string) {
-			if _, err := podClient.Get(ctx, podName, v1.GetOptions{}); err != nil {
-				errChan <- fmt.Errorf("Error getting pod: %w", err)
+		logStream, err := podClient.GetLogs(name, &opts).Stream(ctx)
+		if err != nil {
+			for _, opened := range logs {
+				opened.Close()
 			}
-			req := podClient.GetLogs(podName, &podLogOptions)
-			logStream, err := req.Stream(ctx)
-			if err != nil {
-				errChan <- err
+			return fmt.Errorf("Error getting logs of pod %v: %w", name, err)
+		}
+		logs = append(logs, logStream)
+	}
+	for i, name := range podNames {
+		mux.Add(name, logs[i])
+	}
+	return nil
+}
+
+// WritePodLogsArchive writes the logs of every container of the pods as gzipped tar to w.
+// The logs of a container are written to <pod>/<container>.log, the logs of the previous container
+// to <pod>/<container>.previous.log if the container restarted.
+// Containers that never started have no logs and are left out.
+// A ResourceExhausted error is returned once the logs of all containers exceed limit bytes,
+// the compressed archive is never larger than the logs plus the tar headers.
+func WritePodLogsArchive(ctx context.Context, clientset kubernetes.Interface, pods []corev1.Pod, opts corev1.PodLogOptions, limit int64, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+	remaining := limit
+	for _, pod := range pods {
+		for _, container := range pod.Status.ContainerStatuses {
+			opts := opts
+			opts.Container = container.Name
+			if container.State.Running != nil || container.State.Terminated != nil {
+				written, err := writeLogsFile(ctx, clientset, archive, &pod, fmt.Sprintf("%v/%v.log", pod.Name, container.Name), opts, remaining)
+				if err != nil {
+					return err
+				}
+				remaining -= written
 			}
-			reader := bufio.NewReader(logStream)
-			defer logStream.Close()
-		podLoop:
-			for {
-				select {
-				// blocks, if the channel is open, so the default case will be used
-				// closing the channel will break the loop
-				case <-ctx.Done():
-					logger.Debugw("Stream closed, exiting logs", "pod", podName)
-					break podLoop
-				default:
-					line, isPrefix, err := reader.ReadLine()
-					// only prefix, another line is coming
-					for isPrefix == true {
-						var suffix []byte
-						suffix, isPrefix, err = reader.ReadLine()
-						line = append(line, suffix...)
-					}
-					if err != nil {
-						errChan <- err
-					}
-					if line == nil {
-						continue
-					}
-					err = stream.Send(&rocketpb.LogsResponse{Message: string(line), Pod: podName})
-					if err != nil {
-						errChan <- err
-					}
+			if container.RestartCount > 0 {
+				opts.Previous = true
+				written, err := writeLogsFile(ctx, clientset, archive, &pod, fmt.Sprintf("%v/%v.previous.log", pod.Name, container.Name), opts, remaining)
+				if err != nil {
+					return err
 				}
+				remaining -= written
 			}
-		}(name)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		return fmt.Errorf("Error writing logs archive: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return fmt.Errorf("Error writing logs archive: %w", err)
+	}
+	return nil
+}
 
+// writeLogsFile adds the logs of a container to the archive and returns their size, the logs are read completely first
+// because the size of a file is part of its tar header. At most limit bytes are read.
+func writeLogsFile(ctx context.Context, clientset kubernetes.Interface, archive *tar.Writer, pod *corev1.Pod, filename string, opts corev1.PodLogOptions, limit int64) (int64, error) {
+	// one byte more than the limit shows that the logs don't fit
+	limitBytes := limit + 1
+	opts.LimitBytes = &limitBytes
+	logs, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, &opts).DoRaw(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("Error getting logs of container %v of pod %v: %w", opts.Container, pod.Name, err)
+	}
+	if int64(len(logs)) > limit {
+		return 0, status.Errorf(codes.ResourceExhausted, "The logs exceed the maximum archive size, restrict them to a shorter time")
+	}
+	err = archive.WriteHeader(&tar.Header{
+		Name:    filename,
+		Mode:    0644,
+		Size:    int64(len(logs)),
+		ModTime: time.Now(),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("Error writing logs archive: %w", err)
+	}
+	if _, err := archive.Write(logs); err != nil {
+		return 0, fmt.Errorf("Error writing logs archive: %w", err)
 	}
+	return int64(len(logs)), nil
 }
@@ -3,8 +3,12 @@ package oauth
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/coreos/go-oidc"
 	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type key string
@@ -12,19 +16,85 @@ type key string
 const (
 	// TokenKey is the type to use for extracting the token of the user from the context
 	TokenKey = key("token")
+	// ClaimsKey is the type to use for extracting the verified Claims of the user from the context
+	ClaimsKey = key("claims")
 )
 
-// Middleware is used to authenticate requests
-func Middleware(ctx context.Context) (context.Context, error) {
+// Claims are the claims of a verified token that identify the user
+type Claims struct {
+	Subject           string   `json:"sub"`
+	Email             string   `json:"email"`
+	PreferredUsername string   `json:"preferred_username"`
+	Groups            []string `json:"groups"`
+}
+
+// Username returns the value of the claim that identifies the user, one of sub, email or preferred_username
+func (c *Claims) Username(claim string) (string, error) {
+	var username string
+	switch claim {
+	case "sub":
+		username = c.Subject
+	case "email":
+		username = c.Email
+	case "preferred_username":
+		username = c.PreferredUsername
+	default:
+		return "", fmt.Errorf("Unsupported username claim %v", claim)
+	}
+	if username == "" {
+		return "", fmt.Errorf("Token doesn't contain the username claim %v", claim)
+	}
+	return username, nil
+}
+
+// Authenticator verifies the tokens of requests against the keys of the OIDC issuer
+type Authenticator struct {
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewAuthenticator discovers the OIDC issuer and returns an Authenticator for tokens issued to clientID.
+// The signing keys of the issuer are cached and refetched when a token is signed with an unknown key.
+func NewAuthenticator(ctx context.Context, issuerURL, clientID string) (*Authenticator, error) {
+	provider, err := oidc.NewProvider(ctx, issuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("Error discovering oidc issuer %v: %w", issuerURL, err)
+	}
+	return &Authenticator{
+		verifier: provider.Verifier(&oidc.Config{ClientID: clientID}),
+	}, nil
+}
+
+// Middleware is used to authenticate requests.
+// The signature, audience and expiry of the bearer token are verified before the token and its claims are added to the context.
+func (a *Authenticator) Middleware(ctx context.Context) (context.Context, error) {
 	rawToken, err := GetAuthTokenFromContext(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	token, err := a.verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid token: %v", err)
+	}
+	claims := &Claims{}
+	if err := token.Claims(claims); err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid token claims: %v", err)
+	}
+
 	ctx = context.WithValue(ctx, TokenKey, rawToken)
+	ctx = context.WithValue(ctx, ClaimsKey, claims)
 	return ctx, nil
 }
 
 func GetAuthTokenFromContext(ctx context.Context) (string, error) {
 	return grpc_auth.AuthFromMD(ctx, "bearer")
 }
+
+// GetClaimsFromContext returns the claims that were added to the context by the Middleware
+func GetClaimsFromContext(ctx context.Context) (*Claims, error) {
+	claims, ok := ctx.Value(ClaimsKey).(*Claims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "Request is not authenticated")
+	}
+	return claims, nil
+}
@@ -0,0 +1,174 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testClientID = "kubernetes"
+
+// testIssuer is a local stand-in for the OIDC issuer serving the discovery document and the JWKS
+type testIssuer struct {
+	*httptest.Server
+	mu  sync.Mutex
+	key *jose.JSONWebKey
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	issuer := &testIssuer{}
+	issuer.rotate(t, "key-1")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"issuer":                                issuer.URL,
+			"jwks_uri":                              issuer.URL + "/keys",
+			"authorization_endpoint":                issuer.URL + "/auth",
+			"token_endpoint":                        issuer.URL + "/token",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.mu.Lock()
+		defer issuer.mu.Unlock()
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{issuer.key.Public()}})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+// rotate replaces the signing key of the issuer
+func (i *testIssuer) rotate(t *testing.T, keyID string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.key = &jose.JSONWebKey{Key: privateKey, KeyID: keyID, Algorithm: string(jose.RS256), Use: "sig"}
+}
+
+func (i *testIssuer) sign(t *testing.T, claims interface{}) string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: i.key}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Fatalf("Failed to create signer: %v", err)
+	}
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatalf("Failed to sign token: %v", err)
+	}
+	return token
+}
+
+func (i *testIssuer) claims(audience string, expiry time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"iss":                i.URL,
+		"sub":                "6f1c1d9e",
+		"aud":                audience,
+		"exp":                expiry.Unix(),
+		"iat":                time.Now().Unix(),
+		"email":              "foo@example.com",
+		"preferred_username": "foo",
+		"groups":             []string{"tenants"},
+	}
+}
+
+func contextWithToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "bearer "+token))
+}
+
+func TestAuthenticator_Middleware(t *testing.T) {
+	issuer := newTestIssuer(t)
+	otherIssuer := newTestIssuer(t)
+	authenticator, err := NewAuthenticator(context.Background(), issuer.URL, testClientID)
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr bool
+	}{
+		{
+			name: "valid token",
+			ctx:  contextWithToken(issuer.sign(t, issuer.claims(testClientID, time.Now().Add(time.Hour)))),
+		},
+		{
+			name:    "missing token",
+			ctx:     context.Background(),
+			wantErr: true,
+		},
+		{
+			name:    "garbage token",
+			ctx:     contextWithToken("foo"),
+			wantErr: true,
+		},
+		{
+			name:    "wrong audience",
+			ctx:     contextWithToken(issuer.sign(t, issuer.claims("other-client", time.Now().Add(time.Hour)))),
+			wantErr: true,
+		},
+		{
+			name:    "expired token",
+			ctx:     contextWithToken(issuer.sign(t, issuer.claims(testClientID, time.Now().Add(-time.Hour)))),
+			wantErr: true,
+		},
+		{
+			name:    "signed by unknown key",
+			ctx:     contextWithToken(otherIssuer.sign(t, issuer.claims(testClientID, time.Now().Add(time.Hour)))),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := authenticator.Middleware(tt.ctx)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			claims, err := GetClaimsFromContext(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, &Claims{
+				Subject:           "6f1c1d9e",
+				Email:             "foo@example.com",
+				PreferredUsername: "foo",
+				Groups:            []string{"tenants"},
+			}, claims)
+			assert.NotEmpty(t, ctx.Value(TokenKey))
+		})
+	}
+}
+
+func TestAuthenticator_Middleware_keyRotation(t *testing.T) {
+	issuer := newTestIssuer(t)
+	authenticator, err := NewAuthenticator(context.Background(), issuer.URL, testClientID)
+	if err != nil {
+		t.Fatalf("Failed to create authenticator: %v", err)
+	}
+
+	_, err = authenticator.Middleware(contextWithToken(issuer.sign(t, issuer.claims(testClientID, time.Now().Add(time.Hour)))))
+	assert.NoError(t, err)
+
+	issuer.rotate(t, "key-2")
+	_, err = authenticator.Middleware(contextWithToken(issuer.sign(t, issuer.claims(testClientID, time.Now().Add(time.Hour)))))
+	assert.NoError(t, err)
+}
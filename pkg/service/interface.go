@@ -5,15 +5,70 @@ import (
 
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
 	"github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// Image repositories of the rocket components
+const (
+	RocketRepository  = "rocketchat/rocket.chat"
+	MongodbRepository = "bitnami/mongodb"
+)
+
+// MaxLogsArchiveSize is the maximum size of the logs of a rocket in an archive,
+// larger archives are rejected instead of being held in memory
+const MaxLogsArchiveSize = 32 << 20
+
+// RocketPage is a page of the rockets returned by GetAll
+type RocketPage struct {
+	Rockets []v1alpha1.Rocket
+	// NextPageToken is empty on the last page
+	NextPageToken string
+	// TotalSize is 0 if the cluster doesn't report the amount of remaining rockets
+	TotalSize int64
+}
+
 // RocketService
 type RocketService interface {
-	Logs(name, namespace, pod string, stream rocketpb.RocketService_LogsServer) error
-	GetAll(ctx context.Context, namespace string) (*v1alpha1.RocketList, error)
+	Logs(req *rocketpb.LogsRequest, stream rocketpb.RocketService_LogsServer) error
+	LogsArchive(ctx context.Context, req *rocketpb.LogsArchiveRequest) ([]byte, error)
+	Events(ctx context.Context, name, namespace string) ([]corev1.Event, error)
+	WatchEvents(req *rocketpb.WatchEventsRequest, stream rocketpb.RocketService_WatchEventsServer) error
+	GetAll(ctx context.Context, req *rocketpb.GetAllRequest) (*RocketPage, error)
 	Get(ctx context.Context, name, namespace string) (*v1alpha1.Rocket, error)
-	Create(ctx context.Context, host, name, namespace, email, user string, databaseSize int64, replicas int32) error
+	Create(ctx context.Context, host, name, namespace, email, user, rocketVersion, mongodbVersion, ingressClass string, databaseSize int64, replicas int32, dryRun bool) (*v1alpha1.Rocket, error)
+	Update(ctx context.Context, req *rocketpb.UpdateRequest) (*v1alpha1.Rocket, error)
 	Status(name, namespace string, stream rocketpb.RocketService_StatusServer) error
-	Delete(ctx context.Context, name, namespace string) error
-	AvailableVersions(repo string) ([]string, error)
+	WatchRockets(req *rocketpb.WatchRocketsRequest, stream rocketpb.RocketService_WatchRocketsServer) error
+	Delete(ctx context.Context, name, namespace string, dryRun bool) (*v1alpha1.Rocket, []string, error)
+	AvailableVersions(ctx context.Context, repo string) ([]string, error)
+	GetIssuer(ctx context.Context, namespace, user string) (*cmapi.Issuer, error)
+	UpdateIssuer(ctx context.Context, req *rocketpb.UpdateIssuerRequest) (*cmapi.Issuer, error)
+	Certificate(ctx context.Context, name, namespace string) (*CertificateStatus, error)
+	IngressClasses(ctx context.Context) ([]networkingv1.IngressClass, error)
+	CheckHostAvailability(ctx context.Context, host, name, namespace string) error
+}
+
+// CertificateStatus is the cert-manager certificate of the host of a rocket
+type CertificateStatus struct {
+	Certificate cmapi.Certificate
+	// FailureReason is why the last issuance failed, empty if it didn't fail
+	FailureReason string
+}
+
+// Tenant is the namespace provisioned for a user with its quota
+type Tenant struct {
+	Namespace corev1.Namespace
+	// Quota is nil if the quota of the tenant was deleted
+	Quota *corev1.ResourceQuota
+}
+
+// TenantService provisions the tenants of the users identified by the subject of their token
+type TenantService interface {
+	Register(ctx context.Context, cpu, memory, storage resource.Quantity) (*Tenant, error)
+	Get(ctx context.Context) (*Tenant, error)
+	List(ctx context.Context) ([]Tenant, error)
+	Deregister(ctx context.Context, force bool) (*Tenant, error)
 }
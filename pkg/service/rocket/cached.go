@@ -0,0 +1,124 @@
+package rocket
+
+import (
+	"context"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// authorizeRockets checks if the user of ctx may use verb on the rockets of the namespace.
+// An empty namespace authorizes the rockets of all namespaces.
+func (r *Rocket) authorizeRockets(ctx context.Context, verb, namespace, name string) error {
+	err := r.authorizer.Authorize(ctx, authorizationv1.ResourceAttributes{
+		Verb:      verb,
+		Group:     chatv1alpha1.SchemeGroupVersion.Group,
+		Resource:  "rockets",
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		ctxzap.Extract(ctx).Info(err.Error())
+	}
+	return err
+}
+
+// cachedPage serves a page of GetAll from the cache.
+// All matching rockets are sorted before the page is cut, so the order is stable across pages.
+func (r *Rocket) cachedPage(ctx context.Context, req *rocketpb.GetAllRequest, selector labels.Selector, token pageToken) (*service.RocketPage, error) {
+	err := r.authorizeRockets(ctx, "list", req.GetNamespace(), "")
+	if err != nil {
+		return nil, err
+	}
+	rockets, err := r.cache.ListRockets(req.GetNamespace(), selector)
+	if err != nil {
+		return nil, err
+	}
+	sortRockets(rockets, req.GetOrderBy())
+
+	total := int64(len(rockets))
+	start := token.Offset
+	if start > total {
+		start = total
+	}
+	end := total
+	if size := int64(req.GetPageSize()); size > 0 && start+size < total {
+		end = start + size
+	}
+	page := &service.RocketPage{
+		Rockets:   rockets[start:end],
+		TotalSize: total,
+	}
+	if end < total {
+		page.NextPageToken = pageToken{Offset: end}.encode()
+	}
+	return page, nil
+}
+
+// statusResyncInterval is the time after which the rocket of a status stream is read from the cache again,
+// in case the events of its last changes were dropped
+const statusResyncInterval = 30 * time.Second
+
+// cachedStatus serves Status from the cache.
+// Events of the cache are dropped for streams that don't keep up, so the current state
+// of the rocket is read from the cache on every event and every statusResyncInterval,
+// instead of sending the events themselves.
+func (r *Rocket) cachedStatus(name, namespace string, stream rocketpb.RocketService_StatusServer) error {
+	ctx := stream.Context()
+	for _, verb := range []string{"get", "watch"} {
+		err := r.authorizeRockets(ctx, verb, namespace, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	// watch before reading the rocket, so no change is missed in between
+	watcher := r.cache.WatchRockets()
+	defer watcher.Stop()
+	rocket, err := r.cache.GetRocket(namespace, name)
+	if err != nil {
+		return err
+	}
+	err = sendStatus(stream, watch.Added, rocket)
+	if err != nil {
+		return err
+	}
+
+	resync := time.NewTicker(statusResyncInterval)
+	defer resync.Stop()
+	resourceVersion := rocket.ResourceVersion
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+		case <-resync.C:
+		}
+		rocket, err := r.cache.GetRocket(namespace, name)
+		if apiErrors.IsNotFound(err) {
+			return stream.Send(&rocketpb.StatusResponse{Type: rocketpb.EventType_EVENT_TYPE_DELETED})
+		}
+		if err != nil {
+			return err
+		}
+		if rocket.ResourceVersion == resourceVersion {
+			continue
+		}
+		err = sendStatus(stream, watch.Modified, rocket)
+		if err != nil {
+			return err
+		}
+		resourceVersion = rocket.ResourceVersion
+	}
+}
@@ -0,0 +1,122 @@
+package rocket
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	chatv1alpha1Client "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakeAuthorizer allows the access to the rockets of the allowed namespaces and records the reviewed attributes
+type fakeAuthorizer struct {
+	allowed  map[string]bool
+	reviewed []authorizationv1.ResourceAttributes
+}
+
+func (a *fakeAuthorizer) Authorize(_ context.Context, attributes authorizationv1.ResourceAttributes) error {
+	a.reviewed = append(a.reviewed, attributes)
+	if !a.allowed[attributes.Namespace] {
+		return status.Error(codes.PermissionDenied, "denied")
+	}
+	return nil
+}
+
+func newCachedService(t *testing.T, authorizer k8sutil.Authorizer, rockets ...chatv1alpha1.Rocket) (*Rocket, chatv1alpha1Client.ChatV1alpha1Interface) {
+	chatclient := testutils.NewFakeChatClient(rockets...)
+	cache := k8sutil.NewRocketCache(chatclient, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	assert.NoError(t, cache.Run(ctx))
+	// requests must not reach the cluster with the clients of the user
+	return NewRocketServiceImpl(testutils.NewFakeClientFactory(nil, nil), WithCache(cache, authorizer)), chatclient
+}
+
+func TestRocket_Get_cached(t *testing.T) {
+	authorizer := &fakeAuthorizer{allowed: map[string]bool{TestNamespace: true}}
+	s, _ := newCachedService(t, authorizer,
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: TestNamespace}},
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "other"}},
+	)
+
+	rocket, err := s.Get(context.TODO(), "foo", TestNamespace)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", rocket.Name)
+	assert.Equal(t, authorizationv1.ResourceAttributes{Verb: "get", Group: "chat.accso.de", Resource: "rockets", Namespace: TestNamespace, Name: "foo"}, authorizer.reviewed[0])
+
+	_, err = s.Get(context.TODO(), "bar", TestNamespace)
+	assert.Error(t, err)
+	_, err = s.Get(context.TODO(), "foo", "other")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestRocket_GetAll_cached(t *testing.T) {
+	authorizer := &fakeAuthorizer{allowed: map[string]bool{TestNamespace: true}}
+	s, _ := newCachedService(t, authorizer,
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: TestNamespace}},
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: TestNamespace, Labels: map[string]string{"team": "x"}}},
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: TestNamespace}},
+		chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "other"}},
+	)
+
+	var names []string
+	req := &rocketpb.GetAllRequest{Namespace: TestNamespace, PageSize: 2}
+	for {
+		page, err := s.GetAll(context.TODO(), req)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), page.TotalSize)
+		for _, rocket := range page.Rockets {
+			names = append(names, rocket.Name)
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		req.PageToken = page.NextPageToken
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, names)
+	assert.Equal(t, "list", authorizer.reviewed[0].Verb)
+
+	page, err := s.GetAll(context.TODO(), &rocketpb.GetAllRequest{Namespace: TestNamespace, LabelSelector: "team=x"})
+	assert.NoError(t, err)
+	assert.Len(t, page.Rockets, 1)
+	assert.Empty(t, page.NextPageToken)
+
+	_, err = s.GetAll(context.TODO(), &rocketpb.GetAllRequest{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err), "listing all namespaces needs a cluster wide permission")
+
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"offset":-1}`))
+	_, err = s.GetAll(context.TODO(), &rocketpb.GetAllRequest{Namespace: TestNamespace, PageSize: 2, PageToken: forged})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "negative offsets are rejected")
+}
+
+func TestRocket_Status_cached(t *testing.T) {
+	authorizer := &fakeAuthorizer{allowed: map[string]bool{TestNamespace: true}}
+	s, chatclient := newCachedService(t, authorizer, *statusRocket("1", "Creating"))
+	stream := testutils.NewFakeStatusStream(context.Background())
+	errs := runStatus(s, stream)
+
+	resp := receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_ADDED, resp.Type)
+	assert.Equal(t, "Creating", resp.Status)
+
+	_, err := chatclient.Rockets(TestNamespace).Update(context.TODO(), statusRocket("2", "Running"), metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	resp = receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_MODIFIED, resp.Type)
+	assert.Equal(t, "Running", resp.Status)
+
+	err = chatclient.Rockets(TestNamespace).Delete(context.TODO(), "foo", metav1.DeleteOptions{})
+	assert.NoError(t, err)
+	resp = receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_DELETED, resp.Type)
+	assert.NoError(t, <-errs)
+}
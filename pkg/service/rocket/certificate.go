@@ -0,0 +1,214 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+)
+
+// Certificate returns the cert-manager certificate of the host of the rocket
+// and the reason its last issuance failed, reported by the ACME challenge, order or certificate request
+func (r *Rocket) Certificate(ctx context.Context, name, namespace string) (*service.CertificateStatus, error) {
+	l := ctxzap.Extract(ctx)
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	certclient, err := r.clients.CertManagerClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating cert-manager Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	rocket, err := chatclient.Rockets(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	certificates, err := certclient.CertmanagerV1().Certificates(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		err = fmt.Errorf("Error getting certificates from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	certificate := certificateForHost(certificates.Items, rocket)
+	if certificate == nil {
+		return nil, status.Errorf(codes.NotFound, "No certificate found for host %v of rocket %v", rocket.Spec.IngressSpec.Host, name)
+	}
+	reason, err := failureReason(ctx, certclient, certificate)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	return &service.CertificateStatus{Certificate: *certificate, FailureReason: reason}, nil
+}
+
+// certificateForHost returns the certificate for the host of the rocket.
+// The certificate created by cert-manager for the ingress of the rocket is preferred over other certificates of the host.
+func certificateForHost(certificates []cmapi.Certificate, rocket *chatv1alpha1.Rocket) *cmapi.Certificate {
+	host := rocket.Spec.IngressSpec.Host
+	var found *cmapi.Certificate
+	for i := range certificates {
+		certificate := &certificates[i]
+		if !certificateHasHost(certificate, host) {
+			continue
+		}
+		// the ingress is named like the rocket by the operator
+		if ownedBy(certificate.OwnerReferences, "Ingress", rocket.Name) {
+			return certificate
+		}
+		if found == nil || certificate.Name < found.Name {
+			found = certificate
+		}
+	}
+	return found
+}
+
+func certificateHasHost(certificate *cmapi.Certificate, host string) bool {
+	if certificate.Spec.CommonName == host {
+		return true
+	}
+	for _, name := range certificate.Spec.DNSNames {
+		if name == host {
+			return true
+		}
+	}
+	return false
+}
+
+func ownedBy(refs []metav1.OwnerReference, kind, name string) bool {
+	for _, ref := range refs {
+		if ref.Kind == kind && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func ownedByUID(refs []metav1.OwnerReference, uid types.UID) bool {
+	for _, ref := range refs {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// failureReason returns why the last issuance of the certificate failed, empty if it didn't fail.
+// The reason of an ACME challenge is the most specific, e.g. the wrong status code of the HTTP-01 request,
+// followed by the reason of the ACME order, the certificate request and the certificate.
+func failureReason(ctx context.Context, certclient certmanager.Interface, certificate *cmapi.Certificate) (string, error) {
+	requests, err := certclient.CertmanagerV1().CertificateRequests(certificate.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Error getting certificate requests from cluster api: %w", err)
+	}
+	request := latestCertificateRequest(requests.Items, certificate)
+	if request != nil {
+		reason, err := acmeFailureReason(ctx, certclient, request)
+		if err != nil || reason != "" {
+			return reason, err
+		}
+		for _, condition := range request.Status.Conditions {
+			if condition.Type == cmapi.CertificateRequestConditionReady && condition.Status == cmmeta.ConditionFalse &&
+				condition.Reason == cmapi.CertificateRequestReasonFailed {
+				return condition.Message, nil
+			}
+		}
+	}
+	for _, condition := range certificate.Status.Conditions {
+		if condition.Type == cmapi.CertificateConditionIssuing && condition.Status == cmmeta.ConditionFalse &&
+			condition.Reason == cmapi.CertificateRequestReasonFailed {
+			return condition.Message, nil
+		}
+	}
+	return "", nil
+}
+
+// latestCertificateRequest returns the certificate request of the latest revision of the certificate
+func latestCertificateRequest(requests []cmapi.CertificateRequest, certificate *cmapi.Certificate) *cmapi.CertificateRequest {
+	var latest *cmapi.CertificateRequest
+	latestRevision := -1
+	for i := range requests {
+		request := &requests[i]
+		if !ownedByUID(request.OwnerReferences, certificate.UID) {
+			continue
+		}
+		revision, err := strconv.Atoi(request.Annotations[cmapi.CertificateRequestRevisionAnnotationKey])
+		if err != nil {
+			revision = 0
+		}
+		if latest == nil || revision > latestRevision ||
+			(revision == latestRevision && latest.CreationTimestamp.Before(&request.CreationTimestamp)) {
+			latest = request
+			latestRevision = revision
+		}
+	}
+	return latest
+}
+
+// acmeFailureReason returns the reason of the failed or pending challenges of the latest order of the request,
+// or the reason of the order if it failed
+func acmeFailureReason(ctx context.Context, certclient certmanager.Interface, request *cmapi.CertificateRequest) (string, error) {
+	orders, err := certclient.AcmeV1().Orders(request.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Error getting ACME orders from cluster api: %w", err)
+	}
+	var order *cmacme.Order
+	for i := range orders.Items {
+		if !ownedByUID(orders.Items[i].OwnerReferences, request.UID) {
+			continue
+		}
+		if order == nil || order.CreationTimestamp.Before(&orders.Items[i].CreationTimestamp) {
+			order = &orders.Items[i]
+		}
+	}
+	if order == nil || order.Status.State == cmacme.Valid {
+		return "", nil
+	}
+
+	challenges, err := certclient.AcmeV1().Challenges(request.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Error getting ACME challenges from cluster api: %w", err)
+	}
+	pending := ""
+	for _, challenge := range challenges.Items {
+		if !ownedByUID(challenge.OwnerReferences, order.UID) || challenge.Status.Reason == "" {
+			continue
+		}
+		switch challenge.Status.State {
+		case cmacme.Invalid, cmacme.Errored, cmacme.Expired:
+			return challenge.Status.Reason, nil
+		case cmacme.Valid:
+		default:
+			// e.g. the self check of a HTTP-01 challenge that doesn't pass
+			if pending == "" {
+				pending = challenge.Status.Reason
+			}
+		}
+	}
+	if pending != "" {
+		return pending, nil
+	}
+	switch order.Status.State {
+	case cmacme.Invalid, cmacme.Errored, cmacme.Expired:
+		return order.Status.Reason, nil
+	}
+	return "", nil
+}
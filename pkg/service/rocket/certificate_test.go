@@ -0,0 +1,177 @@
+package rocket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	fakeCertmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func ownerRef(kind, name string, uid types.UID) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{Kind: kind, Name: name, UID: uid}}
+}
+
+func TestRocket_Certificate(t *testing.T) {
+	rocket := chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: TestNamespace},
+		Spec: chatv1alpha1.RocketSpec{
+			IngressSpec: chatv1alpha1.RocketIngressSpec{Host: "chat.example.com"},
+		},
+	}
+	notAfter := metav1.NewTime(time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC))
+	renewal := metav1.NewTime(time.Date(2022, 1, 30, 0, 0, 0, 0, time.UTC))
+	certificate := func(name, host string, owners []metav1.OwnerReference, ready cmmeta.ConditionStatus) *cmapi.Certificate {
+		return &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: TestNamespace, UID: types.UID(name), OwnerReferences: owners},
+			Spec: cmapi.CertificateSpec{
+				DNSNames:   []string{host},
+				SecretName: name + "-tls",
+				IssuerRef:  cmmeta.ObjectReference{Name: "bar-issuer"},
+			},
+			Status: cmapi.CertificateStatus{
+				Conditions:  []cmapi.CertificateCondition{{Type: cmapi.CertificateConditionReady, Status: ready}},
+				NotAfter:    &notAfter,
+				RenewalTime: &renewal,
+			},
+		}
+	}
+	request := func(name, revision string, owner types.UID) *cmapi.CertificateRequest {
+		return &cmapi.CertificateRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				Namespace:       TestNamespace,
+				UID:             types.UID(name),
+				Annotations:     map[string]string{cmapi.CertificateRequestRevisionAnnotationKey: revision},
+				OwnerReferences: ownerRef("Certificate", string(owner), owner),
+			},
+		}
+	}
+	order := &cmacme.Order{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo-tls-2-1", Namespace: TestNamespace, UID: "foo-tls-2-1", OwnerReferences: ownerRef("CertificateRequest", "foo-tls-2", "foo-tls-2")},
+		Status:     cmacme.OrderStatus{State: cmacme.Pending},
+	}
+	challenge := func(state cmacme.State, reason string) *cmacme.Challenge {
+		return &cmacme.Challenge{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-tls-2-1-1", Namespace: TestNamespace, OwnerReferences: ownerRef("Order", order.Name, order.UID)},
+			Status:     cmacme.ChallengeStatus{State: state, Reason: reason},
+		}
+	}
+	failedRequest := request("foo-tls-2", "2", "foo-tls")
+	failedRequest.Status.Conditions = []cmapi.CertificateRequestCondition{{
+		Type:    cmapi.CertificateRequestConditionReady,
+		Status:  cmmeta.ConditionFalse,
+		Reason:  cmapi.CertificateRequestReasonFailed,
+		Message: "issuer not ready",
+	}}
+	failedOrder := order.DeepCopy()
+	failedOrder.Status = cmacme.OrderStatus{State: cmacme.Invalid, Reason: "rate limited"}
+
+	ingressOwner := ownerRef("Ingress", "foo", "ingress")
+	tests := []struct {
+		name        string
+		objs        []runtime.Object
+		wantCode    codes.Code
+		wantName    string
+		wantReady   bool
+		wantFailure string
+	}{
+		{
+			name:     "no certificate",
+			objs:     []runtime.Object{certificate("other-tls", "other.example.com", nil, cmmeta.ConditionTrue)},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:      "ready certificate",
+			objs:      []runtime.Object{certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionTrue), request("foo-tls-1", "1", "foo-tls")},
+			wantName:  "foo-tls",
+			wantReady: true,
+		},
+		{
+			name: "certificate of the ingress is preferred",
+			objs: []runtime.Object{
+				certificate("a-tls", "chat.example.com", nil, cmmeta.ConditionFalse),
+				certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionTrue),
+			},
+			wantName:  "foo-tls",
+			wantReady: true,
+		},
+		{
+			name: "pending challenge of the latest request",
+			objs: []runtime.Object{
+				certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionFalse),
+				request("foo-tls-1", "1", "foo-tls"),
+				request("foo-tls-2", "2", "foo-tls"),
+				order,
+				challenge(cmacme.Pending, "Waiting for HTTP-01 challenge propagation: wrong status code '404', expected '200'"),
+			},
+			wantName:    "foo-tls",
+			wantFailure: "Waiting for HTTP-01 challenge propagation: wrong status code '404', expected '200'",
+		},
+		{
+			name: "invalid challenge",
+			objs: []runtime.Object{
+				certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionFalse),
+				request("foo-tls-2", "2", "foo-tls"),
+				order,
+				challenge(cmacme.Invalid, "Error accepting authorization: connection refused"),
+			},
+			wantName:    "foo-tls",
+			wantFailure: "Error accepting authorization: connection refused",
+		},
+		{
+			name: "failed order",
+			objs: []runtime.Object{
+				certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionFalse),
+				request("foo-tls-2", "2", "foo-tls"),
+				failedOrder,
+			},
+			wantName:    "foo-tls",
+			wantFailure: "rate limited",
+		},
+		{
+			name: "failed certificate request",
+			objs: []runtime.Object{
+				certificate("foo-tls", "chat.example.com", ingressOwner, cmmeta.ConditionFalse),
+				failedRequest,
+			},
+			wantName:    "foo-tls",
+			wantFailure: "issuer not ready",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clients := testutils.NewFakeClientFactoryWithCertManager(fake.NewSimpleClientset(), testutils.NewFakeChatClient(rocket), fakeCertmanager.NewSimpleClientset(tt.objs...))
+			s := NewRocketServiceImpl(clients)
+			got, err := s.Certificate(context.TODO(), "foo", TestNamespace)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, tt.wantName, got.Certificate.Name)
+			assert.Equal(t, tt.wantFailure, got.FailureReason)
+			resp := k8sutil.CertificateToResponse(&got.Certificate, got.FailureReason)
+			assert.Equal(t, tt.wantReady, resp.GetReady())
+			assert.Equal(t, notAfter.Unix(), resp.GetNotAfter().GetSeconds())
+			assert.Equal(t, renewal.Unix(), resp.GetRenewalTime().GetSeconds())
+			assert.Equal(t, tt.wantFailure, resp.GetFailureReason())
+		})
+	}
+}
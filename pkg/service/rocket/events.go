@@ -0,0 +1,338 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	chatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// objectRefreshInterval is the minimum time between two lookups of the objects of a rocket while watching its events
+var objectRefreshInterval = 2 * time.Second
+
+// objectRef identifies an object of a rocket by the kind and name used as involvedObject of events
+type objectRef struct {
+	kind, name string
+}
+
+// Events returns the events of the rocket, its pods, volume claims and ingress, merged and sorted by their last timestamp
+func (r *Rocket) Events(ctx context.Context, name, namespace string) ([]v1.Event, error) {
+	l := ctxzap.Extract(ctx)
+	kubeclient, _, rocket, err := r.eventClients(ctx, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	objects, err := rocketObjects(ctx, kubeclient, rocket)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	events, _, err := listObjectEvents(ctx, kubeclient.CoreV1().Events(namespace), objects)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	aggregator := k8sutil.NewEventAggregator()
+	for i := range events {
+		aggregator.Add(&events[i])
+	}
+	return aggregator.Events(), nil
+}
+
+// WatchEvents sends the events of the rocket sorted by their last timestamp and keeps sending events as they occur,
+// until the stream is canceled. Recurring events are sent again with their merged count.
+// Pods and volume claims that are created later, e.g. during a rolling upgrade, are included.
+func (r *Rocket) WatchEvents(req *rocketpb.WatchEventsRequest, stream rocketpb.RocketService_WatchEventsServer) error {
+	ctx := stream.Context()
+	l := ctxzap.Extract(ctx)
+	kubeclient, rockets, rocket, err := r.eventClients(ctx, req.GetName(), req.GetNamespace())
+	if err != nil {
+		return err
+	}
+	w := &eventWatch{
+		kubeclient: kubeclient,
+		rockets:    rockets,
+		rocket:     rocket,
+		aggregator: k8sutil.NewEventAggregator(),
+		unrelated:  make(map[objectRef]bool),
+		send: func(event *v1.Event) error {
+			return stream.Send(&rocketpb.WatchEventsResponse{Event: k8sutil.EventToResponse(event)})
+		},
+	}
+	err = w.run(ctx)
+	if err != nil {
+		l.Error(err.Error())
+	}
+	return err
+}
+
+// eventClients returns the clients of the user and the rocket whose events are requested
+func (r *Rocket) eventClients(ctx context.Context, name, namespace string) (kubernetes.Interface, chatClient.RocketInterface, *chatv1alpha1.Rocket, error) {
+	l := ctxzap.Extract(ctx)
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, nil, nil, err
+	}
+
+	kubeclient, err := r.clients.KubeClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, nil, nil, err
+	}
+
+	rockets := chatclient.Rockets(namespace)
+	rocket, err := rockets.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, nil, nil, err
+	}
+	return kubeclient, rockets, rocket, nil
+}
+
+// rocketObjects returns the objects whose events belong to the rocket: the rocket, its ingress,
+// the pods of its status and the pods and volume claims labelled by the operator for the rocket
+func rocketObjects(ctx context.Context, kubeclient kubernetes.Interface, rocket *chatv1alpha1.Rocket) (map[objectRef]bool, error) {
+	objects := map[objectRef]bool{
+		{kind: "Rocket", name: rocket.Name}: true,
+		// the ingress is named like the rocket by the operator
+		{kind: "Ingress", name: rocket.Name}: true,
+	}
+	// the events of deleted pods are still relevant
+	for _, embedded := range rocket.Status.Pods {
+		objects[objectRef{kind: "Pod", name: embedded.Name}] = true
+	}
+	// the volume claims of the statefulset have the labels of its pods
+	opts := metav1.ListOptions{LabelSelector: rocketPodSelector(rocket.Name, rocketpb.LogsRequest_COMPONENT_UNSPECIFIED).String()}
+	pods, err := kubeclient.CoreV1().Pods(rocket.Namespace).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting pods of rocket from cluster api: %w", err)
+	}
+	for _, pod := range pods.Items {
+		objects[objectRef{kind: "Pod", name: pod.Name}] = true
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				objects[objectRef{kind: "PersistentVolumeClaim", name: volume.PersistentVolumeClaim.ClaimName}] = true
+			}
+		}
+	}
+	claims, err := kubeclient.CoreV1().PersistentVolumeClaims(rocket.Namespace).List(ctx, opts)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting volume claims of rocket from cluster api: %w", err)
+	}
+	for _, claim := range claims.Items {
+		objects[objectRef{kind: "PersistentVolumeClaim", name: claim.Name}] = true
+	}
+	return objects, nil
+}
+
+// listObjectEvents lists the events of every object with an involvedObject field selector,
+// so only the events of the rocket are read instead of all events of the namespace.
+// The returned resourceVersion is the one of the first list, watching from it doesn't miss events of the later lists.
+func listObjectEvents(ctx context.Context, eventClient typedv1.EventInterface, objects map[objectRef]bool) ([]v1.Event, string, error) {
+	var events []v1.Event
+	var resourceVersion string
+	seen := make(map[types.UID]bool)
+	for ref := range objects {
+		selector := fields.Set{"involvedObject.kind": ref.kind, "involvedObject.name": ref.name}.AsSelector()
+		list, err := eventClient.List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+		if err != nil {
+			return nil, "", fmt.Errorf("Error getting events from cluster api: %w", err)
+		}
+		if resourceVersion == "" {
+			resourceVersion = list.ResourceVersion
+		}
+		for i := range list.Items {
+			if seen[list.Items[i].UID] || !objects[involvedObject(&list.Items[i])] {
+				continue
+			}
+			seen[list.Items[i].UID] = true
+			events = append(events, list.Items[i])
+		}
+	}
+	return events, resourceVersion, nil
+}
+
+func involvedObject(event *v1.Event) objectRef {
+	return objectRef{kind: event.InvolvedObject.Kind, name: event.InvolvedObject.Name}
+}
+
+// eventWatch sends the events of the objects of a rocket
+type eventWatch struct {
+	kubeclient kubernetes.Interface
+	rockets    chatClient.RocketInterface
+	rocket     *chatv1alpha1.Rocket
+	objects    map[objectRef]bool
+	// unrelated are the pods and volume claims with events that weren't found among the objects of the rocket
+	unrelated map[objectRef]bool
+	// pending are the events of unknown pods and volume claims that wait for the objects to be looked up again
+	pending []v1.Event
+	// refreshed is the time the objects were looked up
+	refreshed  time.Time
+	aggregator *k8sutil.EventAggregator
+	send       func(event *v1.Event) error
+}
+
+// run lists the events and watches them from the listed resourceVersion, until ctx is done.
+// If the watch expires, the events are listed again and only the events that changed are sent.
+func (w *eventWatch) run(ctx context.Context) error {
+	eventClient := w.kubeclient.CoreV1().Events(w.rocket.Namespace)
+	for {
+		if err := w.refreshObjects(ctx); err != nil {
+			return err
+		}
+		// the pending events are listed again
+		w.pending = nil
+		events, resourceVersion, err := listObjectEvents(ctx, eventClient, w.objects)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		var changed []v1.Event
+		for i := range events {
+			if merged, ok := w.aggregator.Add(&events[i]); ok {
+				changed = append(changed, *merged)
+			}
+		}
+		k8sutil.SortEvents(changed)
+		for i := range changed {
+			if err := w.send(&changed[i]); err != nil {
+				return err
+			}
+		}
+
+		watcher, err := eventClient.Watch(ctx, metav1.ListOptions{ResourceVersion: resourceVersion})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("Error watching events: %w", err)
+		}
+		err = w.consume(ctx, watcher)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil && !isExpired(err) {
+			return err
+		}
+		// events may have occurred while the watch was closed, list them again
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// consume handles the events of watcher until it is closed or ctx is done.
+// Events of pods and volume claims that aren't known yet look up the objects of the rocket again,
+// at most once per objectRefreshInterval.
+func (w *eventWatch) consume(ctx context.Context, watcher watch.Interface) error {
+	defer watcher.Stop()
+	var refresh <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch e.Type {
+			case watch.Error:
+				return apiErrors.FromObject(e.Object)
+			case watch.Added, watch.Modified:
+				event, ok := e.Object.(*v1.Event)
+				if !ok {
+					continue
+				}
+				if w.mayBelongToRocket(involvedObject(event)) {
+					// the object may have been created after the objects of the rocket were looked up
+					w.pending = append(w.pending, *event)
+					if refresh == nil {
+						refresh = time.After(time.Until(w.refreshed.Add(objectRefreshInterval)))
+					}
+					continue
+				}
+				if err := w.handle(event); err != nil {
+					return err
+				}
+			}
+		case <-refresh:
+			refresh = nil
+			if err := w.refreshObjects(ctx); err != nil {
+				return err
+			}
+			pending := w.pending
+			w.pending = nil
+			for i := range pending {
+				if ref := involvedObject(&pending[i]); !w.objects[ref] {
+					w.unrelated[ref] = true
+				}
+				if err := w.handle(&pending[i]); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// handle sends the merged event if it belongs to the rocket and changed
+func (w *eventWatch) handle(event *v1.Event) error {
+	if !w.objects[involvedObject(event)] {
+		return nil
+	}
+	merged, changed := w.aggregator.Add(event)
+	if !changed {
+		return nil
+	}
+	return w.send(merged)
+}
+
+// mayBelongToRocket reports if the object is a pod or volume claim that wasn't looked up yet.
+// Events are created after their object, so objects that weren't found by a later lookup belong to other rockets.
+func (w *eventWatch) mayBelongToRocket(ref objectRef) bool {
+	switch ref.kind {
+	case "Pod", "PersistentVolumeClaim":
+		return !w.objects[ref] && !w.unrelated[ref]
+	default:
+		return false
+	}
+}
+
+// refreshObjects looks up the rocket and its objects again
+func (w *eventWatch) refreshObjects(ctx context.Context) error {
+	if w.objects != nil {
+		rocket, err := w.rockets.Get(ctx, w.rocket.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting rocket from cluster api: %w", err)
+		}
+		w.rocket = rocket
+	}
+	objects, err := rocketObjects(ctx, w.kubeclient, w.rocket)
+	if err != nil {
+		return err
+	}
+	w.objects = objects
+	w.refreshed = time.Now()
+	return nil
+}
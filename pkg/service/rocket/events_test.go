@@ -0,0 +1,179 @@
+package rocket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var eventTime = time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+
+func rocketEvent(name, kind, object, reason string, count int32, last time.Duration) *v1.Event {
+	return &v1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: TestNamespace, UID: types.UID(name)},
+		InvolvedObject: v1.ObjectReference{Kind: kind, Name: object, Namespace: TestNamespace},
+		Type:           v1.EventTypeWarning,
+		Reason:         reason,
+		Message:        reason + " " + object,
+		Count:          count,
+		FirstTimestamp: metav1.NewTime(eventTime),
+		LastTimestamp:  metav1.NewTime(eventTime.Add(last)),
+		Source:         v1.EventSource{Component: "kubelet"},
+	}
+}
+
+// eventsRocketPod is the mongodb pod of logsRocket with its volume claim
+func eventsRocketPod() *v1.Pod {
+	pod := rocketPod("foo-mongodb-0", "foo", "mongodb")
+	pod.Spec.Volumes = []v1.Volume{{
+		Name: "foo-mongodb-volume",
+		VolumeSource: v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "foo-mongodb-volume-foo-mongodb-0"},
+		},
+	}}
+	return pod
+}
+
+func TestRocket_Events(t *testing.T) {
+	tests := []struct {
+		name        string
+		rocket      string
+		wantReasons []string
+		wantCounts  []int32
+		wantCode    codes.Code
+	}{
+		{
+			name:        "events of rocket",
+			rocket:      "foo",
+			wantReasons: []string{"FailedScheduling", "ProvisioningFailed", "BackOff", "Sync", "Pulling"},
+			wantCounts:  []int32{1, 2, 5, 1, 1},
+		},
+		{
+			name:     "not existing rocket",
+			rocket:   "baz",
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeclient := fake.NewSimpleClientset(
+				eventsRocketPod(),
+				// pods are selected by the labels of the operator, also if they aren't in the status yet
+				rocketPod("foo-rocketchat-6d4cf56db6-zt4rw", "foo", "webserver"),
+				rocketPod("foo-bar-mongodb-0", "foo-bar", "mongodb"),
+				rocketEvent("e1", "Pod", "foo-mongodb-0", "FailedScheduling", 1, 0),
+				rocketEvent("e2", "PersistentVolumeClaim", "foo-mongodb-volume-foo-mongodb-0", "ProvisioningFailed", 2, time.Minute),
+				// recurring events are merged
+				rocketEvent("e3", "Pod", "foo-rocketchat-6d4cf56db6-7xkq2", "BackOff", 3, 2*time.Minute),
+				rocketEvent("e4", "Pod", "foo-rocketchat-6d4cf56db6-7xkq2", "BackOff", 2, 3*time.Minute),
+				rocketEvent("e5", "Ingress", "foo", "Sync", 1, 4*time.Minute),
+				rocketEvent("e6", "Pod", "foo-rocketchat-6d4cf56db6-zt4rw", "Pulling", 1, 5*time.Minute),
+				// events of other rockets
+				rocketEvent("e7", "Pod", "bar-mongodb-0", "FailedScheduling", 1, 0),
+				rocketEvent("e8", "Ingress", "bar", "Sync", 1, 0),
+				rocketEvent("e9", "Pod", "foo-bar-mongodb-0", "FailedScheduling", 1, 0),
+			)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, testutils.NewFakeChatClient(logsRocket())))
+			events, err := s.Events(context.Background(), tt.rocket, TestNamespace)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			assert.NoError(t, err)
+			var reasons []string
+			var counts []int32
+			for _, event := range events {
+				reasons = append(reasons, event.Reason)
+				counts = append(counts, event.Count)
+			}
+			assert.Equal(t, tt.wantReasons, reasons)
+			assert.Equal(t, tt.wantCounts, counts)
+		})
+	}
+}
+
+func receiveEvent(t *testing.T, stream *testutils.FakeWatchEventsStream) *rocketpb.Event {
+	select {
+	case resp := <-stream.Responses:
+		return resp.Event
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for events")
+		return nil
+	}
+}
+
+func TestRocket_WatchEvents(t *testing.T) {
+	interval := objectRefreshInterval
+	objectRefreshInterval = 100 * time.Millisecond
+	defer func() { objectRefreshInterval = interval }()
+
+	rocket := logsRocket()
+	kubeclient := fake.NewSimpleClientset(
+		eventsRocketPod(),
+		rocketEvent("e1", "Pod", "foo-mongodb-0", "FailedScheduling", 1, time.Minute),
+		rocketEvent("e2", "Rocket", "foo", "Created", 1, 0),
+	)
+	watching := make(chan struct{})
+	kubeclient.PrependWatchReactor("events", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		close(watching)
+		return false, nil, nil
+	})
+	chatclient := testutils.NewFakeChatClient(rocket)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, chatclient))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := testutils.NewFakeWatchEventsStream(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.WatchEvents(&rocketpb.WatchEventsRequest{Name: "foo", Namespace: TestNamespace}, stream)
+	}()
+
+	// existing events are sent sorted by their last timestamp
+	assert.Equal(t, "Created", receiveEvent(t, stream).Reason)
+	assert.Equal(t, "FailedScheduling", receiveEvent(t, stream).Reason)
+	select {
+	case <-watching:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event watch")
+	}
+
+	events := kubeclient.CoreV1().Events(TestNamespace)
+	// a recurring event is sent again with the merged count
+	_, err := events.Update(context.TODO(), rocketEvent("e1", "Pod", "foo-mongodb-0", "FailedScheduling", 4, 2*time.Minute), metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	event := receiveEvent(t, stream)
+	assert.Equal(t, "FailedScheduling", event.Reason)
+	assert.Equal(t, int32(4), event.Count)
+
+	// events of other rockets are not sent, also if they are named like the pods of the rocket
+	_, err = kubeclient.CoreV1().Pods(TestNamespace).Create(context.TODO(), rocketPod("foo-bar-mongodb-0", "foo-bar", "mongodb"), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	_, err = events.Create(context.TODO(), rocketEvent("e3", "Pod", "foo-bar-mongodb-0", "FailedScheduling", 1, 0), metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	// pods that start later belong to the rocket by their labels
+	_, err = kubeclient.CoreV1().Pods(TestNamespace).Create(context.TODO(), rocketPod("foo-rocketchat-6d4cf56db6-zt4rw", "foo", "webserver"), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	_, err = events.Create(context.TODO(), rocketEvent("e4", "Pod", "foo-rocketchat-6d4cf56db6-zt4rw", "Pulling", 1, 3*time.Minute), metav1.CreateOptions{})
+	assert.NoError(t, err)
+	event = receiveEvent(t, stream)
+	assert.Equal(t, "Pulling", event.Reason)
+	assert.Equal(t, "foo-rocketchat-6d4cf56db6-zt4rw", event.ObjectName)
+
+	cancel()
+	assert.NoError(t, <-errs)
+	assert.Empty(t, stream.Responses)
+}
@@ -0,0 +1,168 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+)
+
+// AllowedDomainsAnnotation on a namespace lists the domains allowed for the hosts of its rockets, separated by commas.
+// It replaces the allowed domains of the server, e.g. for the namespace of a tenant with its own domain.
+const AllowedDomainsAnnotation = "chat.accso.de/allowed-domains"
+
+// HostChecker rejects hosts that are used by other ingresses or rockets of the cluster or aren't in the allowed domains.
+// It uses the credentials of the api-server, because users can't read the ingresses and rockets of other namespaces.
+// The rockets and ingresses using a host are looked up in caches indexed by host, checks are called on every
+// change of the host in the UI.
+type HostChecker struct {
+	kubeclient kubernetes.Interface
+	rockets    *k8sutil.RocketCache
+	ingresses  *k8sutil.IngressCache
+	// allowedDomains are allowed in namespaces without AllowedDomainsAnnotation, every domain is allowed if it is empty
+	allowedDomains []string
+}
+
+// NewHostChecker returns a HostChecker that allows hosts of allowedDomains and their subdomains.
+// Every host is allowed if allowedDomains is empty. The caches need to be running.
+func NewHostChecker(kubeclient kubernetes.Interface, rockets *k8sutil.RocketCache, ingresses *k8sutil.IngressCache, allowedDomains []string) *HostChecker {
+	return &HostChecker{
+		kubeclient:     kubeclient,
+		rockets:        rockets,
+		ingresses:      ingresses,
+		allowedDomains: normalizeDomains(allowedDomains),
+	}
+}
+
+// WithHostChecker rejects the hosts of created and updated rockets that are not available
+func WithHostChecker(checker *HostChecker) Option {
+	return func(r *Rocket) {
+		r.hosts = checker
+	}
+}
+
+// CheckHostAvailability returns an error if a rocket named name in namespace can't use host.
+// An InvalidArgument error is returned for hosts outside of the allowed domains and
+// an AlreadyExists error for hosts of other ingresses or rockets.
+func (r *Rocket) CheckHostAvailability(ctx context.Context, host, name, namespace string) error {
+	if r.hosts == nil {
+		return nil
+	}
+	err := r.hosts.Check(ctx, host, name, namespace)
+	if err != nil {
+		ctxzap.Extract(ctx).Info(err.Error())
+	}
+	return err
+}
+
+// Check returns an error if a rocket named name in namespace can't use host, name is empty for new rockets.
+// The current host of the rocket and the ingresses controlled by it don't conflict with host.
+// Rockets created concurrently with the same host aren't detected.
+func (c *HostChecker) Check(ctx context.Context, host, name, namespace string) error {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	domains, err := c.domains(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if !hostInDomains(host, domains) {
+		return status.Errorf(codes.InvalidArgument, "Host %v isn't in the allowed domains %v", host, strings.Join(domains, ", "))
+	}
+
+	rockets, err := c.rockets.RocketsByHost(host)
+	if err != nil {
+		return fmt.Errorf("Error getting rockets from cache: %w", err)
+	}
+	for _, rocket := range rockets {
+		if rocket.Namespace == namespace && rocket.Name == name {
+			continue
+		}
+		if sameHost(rocket.Spec.IngressSpec.Host, host) {
+			return status.Errorf(codes.AlreadyExists, "Host %v is already used by another rocket", host)
+		}
+	}
+
+	ingresses, err := c.ingresses.IngressesByHost(host)
+	if err != nil {
+		return fmt.Errorf("Error getting ingresses from cache: %w", err)
+	}
+	for _, ingress := range ingresses {
+		if ingress.Namespace == namespace && controlledByRocket(&ingress, name) {
+			continue
+		}
+		for _, rule := range ingress.Spec.Rules {
+			if sameHost(rule.Host, host) {
+				return status.Errorf(codes.AlreadyExists, "Host %v is already used by another ingress", host)
+			}
+		}
+	}
+	return nil
+}
+
+// controlledByRocket returns true if the controller of obj is the rocket named name in the namespace of obj
+func controlledByRocket(obj metav1.Object, name string) bool {
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || name == "" {
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return false
+	}
+	return gv.Group == chatv1alpha1.SchemeGroupVersion.Group && owner.Kind == "Rocket" && owner.Name == name
+}
+
+// domains returns the allowed domains of the namespace
+func (c *HostChecker) domains(ctx context.Context, namespace string) ([]string, error) {
+	ns, err := c.kubeclient.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		return c.allowedDomains, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting namespace from cluster api: %w", err)
+	}
+	annotation, ok := ns.Annotations[AllowedDomainsAnnotation]
+	if !ok {
+		return c.allowedDomains, nil
+	}
+	return normalizeDomains(strings.Split(annotation, ",")), nil
+}
+
+// normalizeDomains returns the domains in lower case without wildcard, leading or trailing dots and empty domains
+func normalizeDomains(domains []string) []string {
+	var normalized []string
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.TrimSpace(strings.ToLower(domain)), "*")
+		domain = strings.Trim(domain, ".")
+		if domain != "" {
+			normalized = append(normalized, domain)
+		}
+	}
+	return normalized
+}
+
+// hostInDomains reports if host is one of the domains or a subdomain of them, every host is in an empty list
+func hostInDomains(host string, domains []string) bool {
+	if len(domains) == 0 {
+		return true
+	}
+	for _, domain := range domains {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameHost(a, b string) bool {
+	return a != "" && strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
@@ -0,0 +1,183 @@
+package rocket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	chatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// newTestHostChecker returns a HostChecker with running caches of the rockets and ingresses of the clients
+func newTestHostChecker(ctx context.Context, t *testing.T, kubeclient *fake.Clientset, chatclient chatClient.ChatV1alpha1Interface, allowedDomains []string) *HostChecker {
+	rockets := k8sutil.NewRocketCache(chatclient, 0)
+	if err := rockets.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ingresses := k8sutil.NewIngressCache(kubeclient, 0)
+	if err := ingresses.Run(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return NewHostChecker(kubeclient, rockets, ingresses, allowedDomains)
+}
+
+func TestHostChecker_Check(t *testing.T) {
+	rocket := chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a"},
+		Spec: chatv1alpha1.RocketSpec{
+			IngressSpec: chatv1alpha1.RocketIngressSpec{Host: "foo.chat.example.com"},
+		},
+	}
+	ingress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "shop", Namespace: "shop"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "shop.example.com"}},
+		},
+	}
+	controller := true
+	ownIngress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a", OwnerReferences: []metav1.OwnerReference{{
+			APIVersion: chatv1alpha1.SchemeGroupVersion.String(), Kind: "Rocket", Name: "foo", Controller: &controller,
+		}}},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "foo.chat.example.com"}},
+		},
+	}
+	// not created by the operator, but named like a rocket
+	unownedIngress := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "tenant-a"},
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{Host: "legacy.chat.example.com"}},
+		},
+	}
+	ownDomain := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tenant-b",
+			Annotations: map[string]string{AllowedDomainsAnnotation: "b.example.org, *.b.example.net"},
+		},
+	}
+	tests := []struct {
+		name      string
+		host      string
+		rocket    string
+		namespace string
+		wantCode  codes.Code
+	}{
+		{
+			name:      "available host",
+			host:      "bar.chat.example.com",
+			namespace: "tenant-a",
+		},
+		{
+			name:      "host of another rocket",
+			host:      "FOO.chat.example.com",
+			namespace: "tenant-c",
+			wantCode:  codes.AlreadyExists,
+		},
+		{
+			name:      "own host of the rocket",
+			host:      "foo.chat.example.com",
+			rocket:    "foo",
+			namespace: "tenant-a",
+		},
+		{
+			name:      "host of another ingress",
+			host:      "shop.example.com",
+			namespace: "tenant-a",
+			wantCode:  codes.AlreadyExists,
+		},
+		{
+			name:      "host of an unowned ingress named like the new rocket",
+			host:      "legacy.chat.example.com",
+			namespace: "tenant-a",
+			wantCode:  codes.AlreadyExists,
+		},
+		{
+			name:      "host of an unowned ingress named like the rocket",
+			host:      "legacy.chat.example.com",
+			rocket:    "bar",
+			namespace: "tenant-a",
+			wantCode:  codes.AlreadyExists,
+		},
+		{
+			name:      "host of the ingress of the rocket for a new rocket",
+			host:      "foo.chat.example.com",
+			namespace: "tenant-a",
+			wantCode:  codes.AlreadyExists,
+		},
+		{
+			name:      "domain not allowed",
+			host:      "chat.example.org",
+			namespace: "tenant-a",
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "domain of the namespace",
+			host:      "chat.b.example.org",
+			namespace: "tenant-b",
+		},
+		{
+			name:      "wildcard domain of the namespace",
+			host:      "b.example.net",
+			namespace: "tenant-b",
+		},
+		{
+			name:      "domain of the server is replaced by the namespace",
+			host:      "bar.chat.example.com",
+			namespace: "tenant-b",
+			wantCode:  codes.InvalidArgument,
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	checker := newTestHostChecker(ctx, t, fake.NewSimpleClientset(ingress, ownIngress, unownedIngress, ownDomain), testutils.NewFakeChatClient(rocket), []string{"chat.example.com", "shop.example.com"})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checker.Check(ctx, tt.host, tt.rocket, tt.namespace)
+			assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+		})
+	}
+}
+
+func TestRocket_Create_hostConflict(t *testing.T) {
+	registry := fakeRegistry{
+		service.RocketRepository:  {"4.0.0"},
+		service.MongodbRepository: {"4.4.10"},
+	}
+	existing := chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "tenant-a"},
+		Spec: chatv1alpha1.RocketSpec{
+			IngressSpec: chatv1alpha1.RocketIngressSpec{Host: "chat.example.com"},
+		},
+	}
+	kubeclient := fake.NewSimpleClientset()
+	chatclient := testutils.NewFakeChatClient(existing)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, chatclient),
+		WithRegistry(registry), WithHostChecker(newTestHostChecker(ctx, t, kubeclient, chatclient, nil)))
+
+	_, err := s.Create(context.TODO(), "chat.example.com", "bar", TestNamespace, "bar@example.com", "bar", "4.0.0", "4.4.10", "", 1, 1, false)
+	assert.Equal(t, codes.AlreadyExists, status.Code(apierror.ToStatus(err)))
+	_, err = chatclient.Rockets(TestNamespace).Get(context.TODO(), "bar", metav1.GetOptions{})
+	assert.Equal(t, codes.NotFound, status.Code(apierror.ToStatus(err)))
+
+	_, err = s.Update(context.TODO(), &rocketpb.UpdateRequest{
+		UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: "tenant-a", Host: "chat.example.com", Replicas: 2},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host", "replicas"}},
+	})
+	assert.NoError(t, err, "the rocket keeps its own host")
+}
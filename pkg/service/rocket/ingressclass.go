@@ -0,0 +1,78 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+)
+
+// WithDefaultIngressClass sets the class of the ingresses of rockets that are created without a class,
+// instead of the default class of the cluster
+func WithDefaultIngressClass(class string) Option {
+	return func(r *Rocket) {
+		r.defaultIngressClass = class
+	}
+}
+
+// IngressClasses returns the ingress classes of the cluster sorted by name
+func (r *Rocket) IngressClasses(ctx context.Context) ([]networkingv1.IngressClass, error) {
+	l := ctxzap.Extract(ctx)
+	kubeclient, err := r.clients.KubeClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	classes, err := kubeclient.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		err = fmt.Errorf("Error getting ingress classes from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	sort.Slice(classes.Items, func(i, j int) bool {
+		return classes.Items[i].Name < classes.Items[j].Name
+	})
+	return classes.Items, nil
+}
+
+// resolveIngressClass returns the ingress class of a rocket: the requested class, which needs to exist in the cluster,
+// the default class of the server or the default class of the cluster.
+// An empty class is returned if neither is set, the ingress is then handled by the controllers of the cluster
+// that watch ingresses without class.
+func (r *Rocket) resolveIngressClass(ctx context.Context, kubeclient kubernetes.Interface, requested string) (string, error) {
+	if requested != "" {
+		_, err := kubeclient.NetworkingV1().IngressClasses().Get(ctx, requested, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			return "", status.Errorf(codes.InvalidArgument, "Ingress class %v doesn't exist", requested)
+		}
+		if err != nil {
+			return "", fmt.Errorf("Error getting ingress class from cluster api: %w", err)
+		}
+		return requested, nil
+	}
+	if r.defaultIngressClass != "" {
+		return r.defaultIngressClass, nil
+	}
+	classes, err := kubeclient.NetworkingV1().IngressClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Error getting ingress classes from cluster api: %w", err)
+	}
+	class := ""
+	for i := range classes.Items {
+		// with several default classes, the first one by name is used
+		if k8sutil.IsDefaultIngressClass(&classes.Items[i]) && (class == "" || classes.Items[i].Name < class) {
+			class = classes.Items[i].Name
+		}
+	}
+	return class, nil
+}
@@ -0,0 +1,233 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	cmacme "github.com/jetstack/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/jetstack/cert-manager/pkg/apis/meta/v1"
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// IssuerMode is the kind of the issuers created for the users
+type IssuerMode string
+
+const (
+	// IssuerModeACME issues certificates by an ACME server with HTTP-01 challenges
+	IssuerModeACME IssuerMode = "acme"
+	// IssuerModeSelfSigned issues self-signed certificates
+	IssuerModeSelfSigned IssuerMode = "selfsigned"
+	// IssuerModeCA issues certificates signed by the CA of a secret in the namespace of the issuer
+	IssuerModeCA IssuerMode = "ca"
+)
+
+// LetsEncryptServer is the production ACME server of Let's Encrypt
+const LetsEncryptServer = "https://acme-v02.api.letsencrypt.org/directory"
+
+// IssuerOptions configure the issuers that are created for users without an issuer
+type IssuerOptions struct {
+	Mode IssuerMode
+	// ACMEServer is the directory url of the ACME server
+	ACMEServer string
+	// IngressClass is the class of the ingress solving the HTTP-01 challenges,
+	// the class of the ingress of the rocket is used if it is empty
+	IngressClass string
+	// CASecret is the name of the secret of the CA key pair, it needs to exist in every namespace
+	CASecret string
+}
+
+// DefaultIssuerOptions issue certificates by Let's Encrypt, solving the challenges with the ingress class of the rocket
+var DefaultIssuerOptions = IssuerOptions{
+	Mode:       IssuerModeACME,
+	ACMEServer: LetsEncryptServer,
+}
+
+// Validate returns an error if the options can't be used to create issuers
+func (o IssuerOptions) Validate() error {
+	switch o.Mode {
+	case IssuerModeACME:
+		if o.ACMEServer == "" {
+			return fmt.Errorf("ACME issuers need a server")
+		}
+	case IssuerModeSelfSigned:
+	case IssuerModeCA:
+		if o.CASecret == "" {
+			return fmt.Errorf("CA issuers need the name of the secret of the CA")
+		}
+	default:
+		return fmt.Errorf("Unknown issuer mode %q", o.Mode)
+	}
+	return nil
+}
+
+// WithIssuer sets the options of the issuers created for users without an issuer
+func WithIssuer(opts IssuerOptions) Option {
+	return func(r *Rocket) {
+		r.issuer = opts
+	}
+}
+
+// IssuerName returns the name of the issuer of the certificates of the rockets of the user
+func IssuerName(user string) string {
+	return user + "-issuer"
+}
+
+// GetIssuer returns the issuer of the user in the namespace
+func (r *Rocket) GetIssuer(ctx context.Context, namespace, user string) (*cmapi.Issuer, error) {
+	l := ctxzap.Extract(ctx)
+	certclient, err := r.clients.CertManagerClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating cert-manager Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	issuer, err := certclient.CertmanagerV1().Issuers(namespace).Get(ctx, IssuerName(user), metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("Error getting issuer from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	return issuer, nil
+}
+
+// UpdateIssuer creates the issuer of the user with the mode of the request or replaces the mode of the existing issuer.
+// Unset fields of an ACME issuer are taken from the issuer options of the server,
+// the challenges are solved with the default ingress class if neither sets a class.
+func (r *Rocket) UpdateIssuer(ctx context.Context, req *rocketpb.UpdateIssuerRequest) (*cmapi.Issuer, error) {
+	l := ctxzap.Extract(ctx)
+	certclient, err := r.clients.CertManagerClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating cert-manager Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	name := IssuerName(req.GetUser())
+	var config cmapi.IssuerConfig
+	switch mode := req.GetMode().(type) {
+	case *rocketpb.UpdateIssuerRequest_Acme:
+		opts := r.issuer
+		opts.Mode = IssuerModeACME
+		if mode.Acme.GetServer() != "" {
+			opts.ACMEServer = mode.Acme.GetServer()
+		}
+		if mode.Acme.GetIngressClass() != "" {
+			opts.IngressClass = mode.Acme.GetIngressClass()
+		}
+		if opts.IngressClass == "" {
+			kubeclient, err := r.clients.KubeClient(ctx)
+			if err != nil {
+				err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+				l.Error(err.Error())
+				return nil, err
+			}
+			opts.IngressClass, err = r.resolveIngressClass(ctx, kubeclient, "")
+			if err != nil {
+				l.Error(err.Error())
+				return nil, err
+			}
+		}
+		config = issuerConfig(name, opts, mode.Acme.GetEmail())
+	case *rocketpb.UpdateIssuerRequest_SelfSigned:
+		config = issuerConfig(name, IssuerOptions{Mode: IssuerModeSelfSigned}, "")
+	case *rocketpb.UpdateIssuerRequest_Ca:
+		config = issuerConfig(name, IssuerOptions{Mode: IssuerModeCA, CASecret: mode.Ca.GetSecretName()}, "")
+	}
+
+	issuers := certclient.CertmanagerV1().Issuers(req.GetNamespace())
+	var issuer *cmapi.Issuer
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := issuers.Get(ctx, name, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			l.Info(fmt.Sprintf("Creating issuer %v", name))
+			issuer, err = issuers.Create(ctx, &cmapi.Issuer{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: req.GetNamespace()},
+				Spec:       cmapi.IssuerSpec{IssuerConfig: config},
+			}, metav1.CreateOptions{})
+			return err
+		}
+		if err != nil {
+			return err
+		}
+		l.Info(fmt.Sprintf("Updating issuer %v", name))
+		current.Spec.IssuerConfig = config
+		issuer, err = issuers.Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		err = fmt.Errorf("Error updating issuer %v: %w", name, err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	return issuer, nil
+}
+
+// ensureIssuer creates the issuer of the user with the issuer options of the server, if it doesn't exist yet.
+// ingressClass is the class of the ingress of the rocket, used for the challenges if the options don't set a class.
+// Existing issuers are left as they are, they may have been changed by UpdateIssuer.
+func (r *Rocket) ensureIssuer(ctx context.Context, certclient certmanager.Interface, namespace, user, email, ingressClass string) error {
+	l := ctxzap.Extract(ctx)
+	name := IssuerName(user)
+	issuers := certclient.CertmanagerV1().Issuers(namespace)
+	_, err := issuers.Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsNotFound(err) {
+		return fmt.Errorf("Error getting issuer from cluster api: %w", err)
+	}
+	opts := r.issuer
+	if opts.IngressClass == "" {
+		opts.IngressClass = ingressClass
+	}
+	l.Info(fmt.Sprintf("Creating issuer %v", name))
+	_, err = issuers.Create(ctx, &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       cmapi.IssuerSpec{IssuerConfig: issuerConfig(name, opts, email)},
+	}, metav1.CreateOptions{})
+	if apiErrors.IsAlreadyExists(err) {
+		// created by a concurrent request
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("Error creating issuer %v: %w", name, err)
+	}
+	return nil
+}
+
+// issuerConfig returns the configuration of the issuer named name, email is the contact of the ACME account
+func issuerConfig(name string, opts IssuerOptions, email string) cmapi.IssuerConfig {
+	switch opts.Mode {
+	case IssuerModeSelfSigned:
+		return cmapi.IssuerConfig{SelfSigned: &cmapi.SelfSignedIssuer{}}
+	case IssuerModeCA:
+		return cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: opts.CASecret}}
+	}
+	solver := cmacme.ACMEChallengeSolver{
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{},
+		},
+	}
+	if opts.IngressClass != "" {
+		class := opts.IngressClass
+		solver.HTTP01.Ingress.Class = &class
+	}
+	return cmapi.IssuerConfig{
+		ACME: &cmacme.ACMEIssuer{
+			Server: opts.ACMEServer,
+			Email:  email,
+			// the key of the ACME account is created by cert-manager
+			PrivateKey: cmmeta.SecretKeySelector{
+				LocalObjectReference: cmmeta.LocalObjectReference{Name: name + "-account-key"},
+			},
+			Solvers: []cmacme.ACMEChallengeSolver{solver},
+		},
+	}
+}
@@ -0,0 +1,198 @@
+package rocket
+
+import (
+	"context"
+	"testing"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
+	fakeCertmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRocket_Create_issuer(t *testing.T) {
+	registry := fakeRegistry{
+		service.RocketRepository:  {"4.0.0"},
+		service.MongodbRepository: {"4.4.10"},
+	}
+	existing := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar-issuer", Namespace: TestNamespace},
+		Spec:       cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{SelfSigned: &cmapi.SelfSignedIssuer{}}},
+	}
+	tests := []struct {
+		name       string
+		issuers    []runtime.Object
+		opts       IssuerOptions
+		dryRun     bool
+		wantIssuer bool
+		want       func(t *testing.T, issuer *cmapi.Issuer)
+	}{
+		{
+			name:       "acme issuer",
+			opts:       DefaultIssuerOptions,
+			wantIssuer: true,
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				if assert.NotNil(t, issuer.Spec.ACME) {
+					assert.Equal(t, LetsEncryptServer, issuer.Spec.ACME.Server)
+					assert.Equal(t, "bar@example.com", issuer.Spec.ACME.Email)
+					assert.Equal(t, "bar-issuer-account-key", issuer.Spec.ACME.PrivateKey.Name)
+					assert.Equal(t, "nginx", *issuer.Spec.ACME.Solvers[0].HTTP01.Ingress.Class)
+				}
+			},
+		},
+		{
+			name:       "ca issuer",
+			opts:       IssuerOptions{Mode: IssuerModeCA, CASecret: "internal-ca"},
+			wantIssuer: true,
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				if assert.NotNil(t, issuer.Spec.CA) {
+					assert.Equal(t, "internal-ca", issuer.Spec.CA.SecretName)
+				}
+			},
+		},
+		{
+			name:       "existing issuer is kept",
+			issuers:    []runtime.Object{existing},
+			opts:       DefaultIssuerOptions,
+			wantIssuer: true,
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				assert.NotNil(t, issuer.Spec.SelfSigned)
+				assert.Nil(t, issuer.Spec.ACME)
+			},
+		},
+		{
+			name:   "dry run",
+			opts:   DefaultIssuerOptions,
+			dryRun: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			certclient := fakeCertmanager.NewSimpleClientset(tt.issuers...)
+			clients := testutils.NewFakeClientFactoryWithCertManager(fake.NewSimpleClientset(defaultIngressClass("nginx")), testutils.NewFakeChatClient(), certclient)
+			s := NewRocketServiceImpl(clients, WithRegistry(registry), WithIssuer(tt.opts))
+			created, err := s.Create(ctx, "chat.example.com", "foo", TestNamespace, "bar@example.com", "bar", "4.0.0", "4.4.10", "", 1, 1, tt.dryRun)
+			assert.NoError(t, err)
+			assert.Equal(t, "bar-issuer", created.Spec.IngressSpec.Annotations["cert-manager.io/issuer"])
+
+			issuer, err := certclient.CertmanagerV1().Issuers(TestNamespace).Get(ctx, "bar-issuer", metav1.GetOptions{})
+			if !tt.wantIssuer {
+				assert.Equal(t, codes.NotFound, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			assert.NoError(t, err)
+			tt.want(t, issuer)
+		})
+	}
+}
+
+func TestRocket_UpdateIssuer(t *testing.T) {
+	existing := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Name: "bar-issuer", Namespace: TestNamespace},
+		Spec:       cmapi.IssuerSpec{IssuerConfig: cmapi.IssuerConfig{SelfSigned: &cmapi.SelfSignedIssuer{}}},
+	}
+	tests := []struct {
+		name    string
+		issuers []runtime.Object
+		req     *rocketpb.UpdateIssuerRequest
+		want    func(t *testing.T, issuer *cmapi.Issuer)
+	}{
+		{
+			name: "create acme issuer with defaults",
+			req: &rocketpb.UpdateIssuerRequest{
+				Namespace: TestNamespace,
+				User:      "bar",
+				Mode:      &rocketpb.UpdateIssuerRequest_Acme{Acme: &rocketpb.AcmeIssuer{Email: "bar@example.com"}},
+			},
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				if assert.NotNil(t, issuer.Spec.ACME) {
+					assert.Equal(t, LetsEncryptServer, issuer.Spec.ACME.Server)
+					assert.Equal(t, "bar@example.com", issuer.Spec.ACME.Email)
+					assert.Equal(t, "nginx", *issuer.Spec.ACME.Solvers[0].HTTP01.Ingress.Class)
+				}
+			},
+		},
+		{
+			name:    "replace self-signed by acme issuer",
+			issuers: []runtime.Object{existing},
+			req: &rocketpb.UpdateIssuerRequest{
+				Namespace: TestNamespace,
+				User:      "bar",
+				Mode: &rocketpb.UpdateIssuerRequest_Acme{Acme: &rocketpb.AcmeIssuer{
+					Server:       "https://acme-staging-v02.api.letsencrypt.org/directory",
+					IngressClass: "internal",
+				}},
+			},
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				assert.Nil(t, issuer.Spec.SelfSigned)
+				if assert.NotNil(t, issuer.Spec.ACME) {
+					assert.Equal(t, "https://acme-staging-v02.api.letsencrypt.org/directory", issuer.Spec.ACME.Server)
+					assert.Equal(t, "internal", *issuer.Spec.ACME.Solvers[0].HTTP01.Ingress.Class)
+				}
+			},
+		},
+		{
+			name:    "replace by ca issuer",
+			issuers: []runtime.Object{existing},
+			req: &rocketpb.UpdateIssuerRequest{
+				Namespace: TestNamespace,
+				User:      "bar",
+				Mode:      &rocketpb.UpdateIssuerRequest_Ca{Ca: &rocketpb.CaIssuer{SecretName: "internal-ca"}},
+			},
+			want: func(t *testing.T, issuer *cmapi.Issuer) {
+				assert.Nil(t, issuer.Spec.SelfSigned)
+				if assert.NotNil(t, issuer.Spec.CA) {
+					assert.Equal(t, "internal-ca", issuer.Spec.CA.SecretName)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			certclient := fakeCertmanager.NewSimpleClientset(tt.issuers...)
+			clients := testutils.NewFakeClientFactoryWithCertManager(fake.NewSimpleClientset(defaultIngressClass("nginx")), testutils.NewFakeChatClient(), certclient)
+			s := NewRocketServiceImpl(clients)
+			updated, err := s.UpdateIssuer(ctx, tt.req)
+			assert.NoError(t, err)
+			tt.want(t, updated)
+
+			issuer, err := s.GetIssuer(ctx, TestNamespace, "bar")
+			assert.NoError(t, err)
+			assert.Equal(t, updated.Spec, issuer.Spec)
+		})
+	}
+}
+
+func defaultIngressClass(name string) *networkingv1.IngressClass {
+	return &networkingv1.IngressClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{networkingv1.AnnotationIsDefaultIngressClass: "true"},
+		},
+	}
+}
+
+func TestRocket_GetIssuer_notFound(t *testing.T) {
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), testutils.NewFakeChatClient()))
+	_, err := s.GetIssuer(context.TODO(), TestNamespace, "bar")
+	assert.Equal(t, codes.NotFound, status.Code(apierror.ToStatus(err)))
+}
+
+func TestIssuerOptions_Validate(t *testing.T) {
+	assert.NoError(t, DefaultIssuerOptions.Validate())
+	assert.NoError(t, IssuerOptions{Mode: IssuerModeSelfSigned}.Validate())
+	assert.Error(t, IssuerOptions{Mode: IssuerModeACME}.Validate())
+	assert.Error(t, IssuerOptions{Mode: IssuerModeCA}.Validate())
+	assert.Error(t, IssuerOptions{Mode: "vault"}.Validate())
+}
@@ -0,0 +1,267 @@
+package rocket
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+const (
+	// webserverComponent is the component label of the pods of the webserver deployment
+	webserverComponent = "webserver"
+	// databaseComponent is the component label of the pods of the mongodb statefulset
+	databaseComponent = "mongodb"
+)
+
+// podFollower attaches the logs of the running pods of a rocket to a mux, as they come and go
+type podFollower struct {
+	opts     v1.PodLogOptions
+	mux      *k8sutil.LogMux
+	selector labels.Selector
+	pods     kubernetes.Interface
+	// openLogs streams the logs of a pod, it is replaced by tests
+	openLogs func(ctx context.Context, pod *v1.Pod, opts *v1.PodLogOptions) (io.ReadCloser, error)
+
+	mu sync.Mutex
+	// followed are the pods whose logs were attached by their uid
+	followed map[types.UID]*followedPod
+}
+
+// followedPod is a pod whose logs were attached
+type followedPod struct {
+	// attached is set while the logs are read by the mux
+	attached bool
+	// opts are the options the logs were attached with first
+	opts v1.PodLogOptions
+	// last is the timestamp of the last line read, logs that are attached again continue after it
+	last time.Time
+}
+
+func newPodFollower(kubeclient kubernetes.Interface, rocketName string, component rocketpb.LogsRequest_Component, opts v1.PodLogOptions, mux *k8sutil.LogMux) *podFollower {
+	return &podFollower{
+		opts:     opts,
+		mux:      mux,
+		selector: rocketPodSelector(rocketName, component),
+		pods:     kubeclient,
+		openLogs: func(ctx context.Context, pod *v1.Pod, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+			return kubeclient.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).Stream(ctx)
+		},
+		followed: make(map[types.UID]*followedPod),
+	}
+}
+
+// rocketPodSelector selects the pods of the component of a rocket by the labels set by the operator
+func rocketPodSelector(rocketName string, component rocketpb.LogsRequest_Component) labels.Selector {
+	components := []string{webserverComponent, databaseComponent}
+	switch component {
+	case rocketpb.LogsRequest_COMPONENT_WEBSERVER:
+		components = []string{webserverComponent}
+	case rocketpb.LogsRequest_COMPONENT_DATABASE:
+		components = []string{databaseComponent}
+	}
+	app, _ := labels.NewRequirement("app", selection.Equals, []string{rocketName})
+	comp, _ := labels.NewRequirement("component", selection.In, components)
+	return labels.NewSelector().Add(*app, *comp)
+}
+
+// run attaches the running pods and keeps attaching pods that start, until ctx is done.
+// The pods present at the start are read with the options of the request,
+// pods that join later are read from the start of their container.
+func (f *podFollower) run(ctx context.Context, namespace string) error {
+	podClient := f.pods.CoreV1().Pods(namespace)
+	opts := metav1.ListOptions{LabelSelector: f.selector.String()}
+	joined := false
+	for {
+		pods, err := podClient.List(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("Error listing pods of rocket: %w", err)
+		}
+		for i := range pods.Items {
+			f.attach(ctx, &pods.Items[i], joined)
+		}
+		joined = true
+
+		watchOpts := opts
+		watchOpts.ResourceVersion = pods.ResourceVersion
+		watcher, err := podClient.Watch(ctx, watchOpts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("Error watching pods of rocket: %w", err)
+		}
+		err = f.consume(ctx, watcher)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil && !isExpired(err) {
+			return err
+		}
+		// pods may have changed while the watch was closed, list them again
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+func (f *podFollower) consume(ctx context.Context, watcher watch.Interface) error {
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			switch event.Type {
+			case watch.Error:
+				return apiErrors.FromObject(event.Object)
+			case watch.Added, watch.Modified:
+				if pod, ok := event.Object.(*v1.Pod); ok {
+					f.attach(ctx, pod, true)
+				}
+			case watch.Deleted:
+				if pod, ok := event.Object.(*v1.Pod); ok {
+					f.forget(pod.UID)
+				}
+			}
+		}
+	}
+}
+
+// attach adds the logs of the pod to the mux, if it is running and not attached yet.
+// Pods that joined after the start are read from the start of their container.
+// The logs of pods that were attached before continue after their last line, e.g. after the kubelet closed the stream.
+func (f *podFollower) attach(ctx context.Context, pod *v1.Pod, joined bool) {
+	if pod.Status.Phase != v1.PodRunning || pod.DeletionTimestamp != nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	followed, known := f.followed[pod.UID]
+	if known && followed.attached {
+		return
+	}
+	if !known {
+		followed = &followedPod{opts: f.opts}
+		if joined {
+			// the whole logs of new containers are relevant
+			followed.opts.TailLines = nil
+			followed.opts.SinceSeconds = nil
+			followed.opts.SinceTime = nil
+		}
+	}
+
+	opts := followed.opts
+	if !followed.last.IsZero() {
+		// the time is sent with a precision of seconds, lines that were already read are skipped by the reader
+		since := metav1.NewTime(followed.last)
+		opts.TailLines = nil
+		opts.SinceSeconds = nil
+		opts.SinceTime = &since
+	}
+	logs, err := f.openLogs(ctx, pod, &opts)
+	if err != nil {
+		// the container may not be started yet, the pod is attached on its next change
+		ctxzap.Extract(ctx).Debug(fmt.Sprintf("Error getting logs of pod %v: %v", pod.Name, err))
+		return
+	}
+	followed.attached = true
+	f.followed[pod.UID] = followed
+	logs = &podLogReader{
+		logs:   logs,
+		reader: bufio.NewReader(logs),
+		after:  followed.last,
+		detach: func(last time.Time) { f.detach(pod.UID, last) },
+	}
+	if joined {
+		f.mux.Join(pod.Name, logs)
+	} else {
+		f.mux.Add(pod.Name, logs)
+	}
+}
+
+// detach allows the pod to be attached again after the logs of its container ended,
+// last is the timestamp of the last line read from them
+func (f *podFollower) detach(uid types.UID, last time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	followed, ok := f.followed[uid]
+	if !ok {
+		return
+	}
+	followed.attached = false
+	if last.After(followed.last) {
+		followed.last = last
+	}
+}
+
+// forget removes a deleted pod
+func (f *podFollower) forget(uid types.UID) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.followed, uid)
+}
+
+// podLogReader reads the logs of a pod line by line, skipping the lines until after, and
+// passes the timestamp of the last line read to detach when the logs are closed
+type podLogReader struct {
+	logs   io.ReadCloser
+	reader *bufio.Reader
+	after  time.Time
+	last   time.Time
+	// line is the rest of the current line
+	line   []byte
+	err    error
+	once   sync.Once
+	detach func(last time.Time)
+}
+
+func (r *podLogReader) Read(p []byte) (int, error) {
+	for len(r.line) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		var line []byte
+		line, r.err = r.reader.ReadBytes('\n')
+		if timestamp, _ := k8sutil.SplitTimestamp(strings.TrimSuffix(string(line), "\n")); !timestamp.IsZero() {
+			if !timestamp.After(r.after) {
+				// read before the logs were attached again
+				continue
+			}
+			r.last = timestamp
+		}
+		r.line = line
+	}
+	n := copy(p, r.line)
+	r.line = r.line[n:]
+	return n, nil
+}
+
+func (r *podLogReader) Close() error {
+	r.once.Do(func() { r.detach(r.last) })
+	return r.logs.Close()
+}
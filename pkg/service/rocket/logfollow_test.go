@@ -0,0 +1,76 @@
+package rocket
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestPodFollower_reattach(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tail := int64(10)
+	mux := k8sutil.NewLogMux(k8sutil.LogMuxOptions{DropPolicy: k8sutil.DropOldest, Timestamps: true})
+	f := newPodFollower(fake.NewSimpleClientset(), "foo", rocketpb.LogsRequest_COMPONENT_UNSPECIFIED,
+		v1.PodLogOptions{Follow: true, Timestamps: true, TailLines: &tail}, mux)
+	opened := make(chan v1.PodLogOptions, 4)
+	f.openLogs = func(_ context.Context, _ *v1.Pod, opts *v1.PodLogOptions) (io.ReadCloser, error) {
+		opened <- *opts
+		// the kubelet sends the lines of the second again, because the time of the request has a precision of seconds
+		return ioutil.NopCloser(strings.NewReader("2021-12-01T10:00:00.5Z first\n2021-12-01T10:00:01.2Z second\n")), nil
+	}
+	detached := func(uid types.UID) func() bool {
+		return func() bool {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			return !f.followed[uid].attached
+		}
+	}
+
+	pod := rocketPod("foo-mongodb-0", "foo", "mongodb")
+	f.attach(ctx, pod, false)
+	opts := <-opened
+	assert.Equal(t, &tail, opts.TailLines, "pods running at the start are read with the options of the request")
+	assert.Eventually(t, detached(pod.UID), 5*time.Second, 10*time.Millisecond)
+
+	// the stream of the running pod ended, it is attached again by its next change
+	f.attach(ctx, pod, true)
+	opts = <-opened
+	assert.Nil(t, opts.TailLines)
+	if assert.NotNil(t, opts.SinceTime) {
+		assert.Equal(t, time.Date(2021, 12, 1, 10, 0, 1, 200000000, time.UTC), opts.SinceTime.UTC())
+	}
+	assert.Eventually(t, detached(pod.UID), 5*time.Second, 10*time.Millisecond)
+
+	joined := rocketPod("foo-rocketchat-6d4cf56db6-zt4rw", "foo", "webserver")
+	f.attach(ctx, joined, true)
+	opts = <-opened
+	assert.Nil(t, opts.TailLines, "pods that join are read from the start")
+	assert.Nil(t, opts.SinceTime)
+	assert.Eventually(t, detached(joined.UID), 5*time.Second, 10*time.Millisecond)
+
+	mux.Close()
+	var lines []string
+	assert.NoError(t, mux.Run(ctx, func(line k8sutil.LogLine) error {
+		if line.Event == k8sutil.LogEventNone {
+			lines = append(lines, line.Pod+": "+line.Message)
+		}
+		return nil
+	}))
+	assert.Equal(t, []string{
+		"foo-mongodb-0: first",
+		"foo-mongodb-0: second",
+		"foo-rocketchat-6d4cf56db6-zt4rw: first",
+		"foo-rocketchat-6d4cf56db6-zt4rw: second",
+	}, lines, "the lines of the pod that was attached again are not repeated")
+}
@@ -0,0 +1,167 @@
+package rocket
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// logEntry is a line of the logs, parsed if it is written in a known structured format
+type logEntry struct {
+	level   rocketpb.LogLevel
+	time    time.Time
+	message string
+	fields  map[string]interface{}
+}
+
+// parseLogLine parses the JSON lines written by Rocket.Chat (pino) and MongoDB (structured logs since 4.4).
+// Other lines are returned as message without level.
+func parseLogLine(line string) logEntry {
+	entry := logEntry{message: line}
+	if !strings.HasPrefix(line, "{") {
+		return entry
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return entry
+	}
+	switch {
+	case hasFields(fields, "t", "s", "msg"):
+		return parseMongodbEntry(fields)
+	case hasFields(fields, "level", "msg"):
+		return parsePinoEntry(fields)
+	}
+	return entry
+}
+
+func hasFields(fields map[string]interface{}, keys ...string) bool {
+	for _, key := range keys {
+		if _, ok := fields[key]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// parseMongodbEntry parses {"t":{"$date":"..."},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"...","attr":{...}}
+func parseMongodbEntry(fields map[string]interface{}) logEntry {
+	entry := logEntry{fields: fields}
+	entry.message, _ = fields["msg"].(string)
+	severity, _ := fields["s"].(string)
+	entry.level = mongodbLevel(severity)
+	if t, ok := fields["t"].(map[string]interface{}); ok {
+		if date, ok := t["$date"].(string); ok {
+			entry.time, _ = time.Parse(time.RFC3339Nano, date)
+		}
+	}
+	delete(fields, "t")
+	delete(fields, "s")
+	delete(fields, "msg")
+	return entry
+}
+
+func mongodbLevel(severity string) rocketpb.LogLevel {
+	switch {
+	case severity == "F":
+		return rocketpb.LogLevel_LOG_LEVEL_FATAL
+	case severity == "E":
+		return rocketpb.LogLevel_LOG_LEVEL_ERROR
+	case severity == "W":
+		return rocketpb.LogLevel_LOG_LEVEL_WARN
+	case severity == "I":
+		return rocketpb.LogLevel_LOG_LEVEL_INFO
+	case strings.HasPrefix(severity, "D"):
+		return rocketpb.LogLevel_LOG_LEVEL_DEBUG
+	default:
+		return rocketpb.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+}
+
+// parsePinoEntry parses {"level":30,"time":1638352800000,"pid":1,"hostname":"...","msg":"..."}
+func parsePinoEntry(fields map[string]interface{}) logEntry {
+	entry := logEntry{fields: fields}
+	entry.message, _ = fields["msg"].(string)
+	level, _ := fields["level"].(float64)
+	entry.level = pinoLevel(level)
+	switch t := fields["time"].(type) {
+	case float64:
+		entry.time = time.UnixMilli(int64(t)).UTC()
+	case string:
+		entry.time, _ = time.Parse(time.RFC3339Nano, t)
+	}
+	delete(fields, "level")
+	delete(fields, "time")
+	delete(fields, "msg")
+	return entry
+}
+
+func pinoLevel(level float64) rocketpb.LogLevel {
+	switch {
+	case level >= 60:
+		return rocketpb.LogLevel_LOG_LEVEL_FATAL
+	case level >= 50:
+		return rocketpb.LogLevel_LOG_LEVEL_ERROR
+	case level >= 40:
+		return rocketpb.LogLevel_LOG_LEVEL_WARN
+	case level >= 30:
+		return rocketpb.LogLevel_LOG_LEVEL_INFO
+	case level >= 20:
+		return rocketpb.LogLevel_LOG_LEVEL_DEBUG
+	case level >= 10:
+		return rocketpb.LogLevel_LOG_LEVEL_TRACE
+	default:
+		return rocketpb.LogLevel_LOG_LEVEL_UNSPECIFIED
+	}
+}
+
+// logFilter decides which lines of the logs are sent
+type logFilter struct {
+	minLevel  rocketpb.LogLevel
+	substring string
+	regex     *regexp.Regexp
+}
+
+func newLogFilter(req *rocketpb.LogsRequest) (*logFilter, error) {
+	f := &logFilter{minLevel: req.GetMinLevel()}
+	if !req.GetFilterRegex() {
+		f.substring = req.GetFilter()
+		return f, nil
+	}
+	regex, err := regexp.Compile(req.GetFilter())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid filter: %v", err)
+	}
+	f.regex = regex
+	return f, nil
+}
+
+// matches reports if entry is sent. Lines without a level are kept, they are often continuations of structured lines.
+func (f *logFilter) matches(entry logEntry) bool {
+	if entry.level != rocketpb.LogLevel_LOG_LEVEL_UNSPECIFIED && entry.level < f.minLevel {
+		return false
+	}
+	if f.regex != nil {
+		return f.regex.MatchString(entry.message)
+	}
+	return strings.Contains(entry.message, f.substring)
+}
+
+// toResponse converts the entry to a response, fields that can't be represented are dropped
+func (e logEntry) toResponse(pod string) *rocketpb.LogsResponse {
+	resp := &rocketpb.LogsResponse{
+		Level:   e.level,
+		Message: e.message,
+		Pod:     pod,
+	}
+	if len(e.fields) > 0 {
+		resp.Fields, _ = structpb.NewStruct(e.fields)
+	}
+	return resp
+}
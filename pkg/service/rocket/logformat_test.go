@@ -0,0 +1,124 @@
+package rocket
+
+import (
+	"testing"
+	"time"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLogLine(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want logEntry
+	}{
+		{
+			name: "rocket.chat",
+			line: `{"level":40,"time":1638352800123,"pid":1,"hostname":"foo-rocketchat-6d4cf56db6-7xkq2","name":"Meteor","msg":"Slow method"}`,
+			want: logEntry{
+				level:   rocketpb.LogLevel_LOG_LEVEL_WARN,
+				time:    time.Date(2021, 12, 1, 10, 0, 0, 123000000, time.UTC),
+				message: "Slow method",
+				fields:  map[string]interface{}{"pid": float64(1), "hostname": "foo-rocketchat-6d4cf56db6-7xkq2", "name": "Meteor"},
+			},
+		},
+		{
+			name: "mongodb",
+			line: `{"t":{"$date":"2021-12-01T10:00:00.123+00:00"},"s":"I","c":"NETWORK","id":22943,"ctx":"listener","msg":"Connection accepted","attr":{"connectionCount":3}}`,
+			want: logEntry{
+				level:   rocketpb.LogLevel_LOG_LEVEL_INFO,
+				time:    time.Date(2021, 12, 1, 10, 0, 0, 123000000, time.UTC),
+				message: "Connection accepted",
+				fields: map[string]interface{}{
+					"c": "NETWORK", "id": float64(22943), "ctx": "listener",
+					"attr": map[string]interface{}{"connectionCount": float64(3)},
+				},
+			},
+		},
+		{
+			name: "mongodb debug",
+			line: `{"t":{"$date":"2021-12-01T10:00:00.000Z"},"s":"D2","c":"QUERY","msg":"Planning"}`,
+			want: logEntry{
+				level:   rocketpb.LogLevel_LOG_LEVEL_DEBUG,
+				time:    time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC),
+				message: "Planning",
+				fields:  map[string]interface{}{"c": "QUERY"},
+			},
+		},
+		{
+			name: "unstructured",
+			line: "Exception in callback of async function: Error: connect ECONNREFUSED",
+			want: logEntry{message: "Exception in callback of async function: Error: connect ECONNREFUSED"},
+		},
+		{
+			name: "unknown json",
+			line: `{"foo":"bar"}`,
+			want: logEntry{message: `{"foo":"bar"}`},
+		},
+		{
+			name: "broken json",
+			line: `{"level":30,`,
+			want: logEntry{message: `{"level":30,`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseLogLine(tt.line)
+			assert.Equal(t, tt.want.level, got.level)
+			assert.True(t, tt.want.time.Equal(got.time), "time %v, want %v", got.time, tt.want.time)
+			assert.Equal(t, tt.want.message, got.message)
+			assert.Equal(t, tt.want.fields, got.fields)
+		})
+	}
+}
+
+func TestLogFilter_matches(t *testing.T) {
+	warn := logEntry{level: rocketpb.LogLevel_LOG_LEVEL_WARN, message: "Slow method sendMessage"}
+	info := logEntry{level: rocketpb.LogLevel_LOG_LEVEL_INFO, message: "Connection accepted"}
+	unstructured := logEntry{message: "    at processTicksAndRejections"}
+	tests := []struct {
+		name    string
+		req     *rocketpb.LogsRequest
+		want    []bool
+		wantErr bool
+	}{
+		{
+			name: "no filter",
+			req:  &rocketpb.LogsRequest{},
+			want: []bool{true, true, true},
+		},
+		{
+			name: "min level",
+			req:  &rocketpb.LogsRequest{MinLevel: rocketpb.LogLevel_LOG_LEVEL_WARN},
+			want: []bool{true, false, true},
+		},
+		{
+			name: "substring",
+			req:  &rocketpb.LogsRequest{Filter: "Slow"},
+			want: []bool{true, false, false},
+		},
+		{
+			name: "regex",
+			req:  &rocketpb.LogsRequest{Filter: "^(Connection|Slow) ", FilterRegex: true},
+			want: []bool{true, true, false},
+		},
+		{
+			name:    "invalid regex",
+			req:     &rocketpb.LogsRequest{Filter: "(", FilterRegex: true},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newLogFilter(tt.req)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, []bool{f.matches(warn), f.matches(info), f.matches(unstructured)})
+		})
+	}
+}
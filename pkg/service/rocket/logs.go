@@ -0,0 +1,272 @@
+package rocket
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// logMergeWindow is the time lines are held back to interleave the logs of several pods by their timestamps
+const logMergeWindow = 500 * time.Millisecond
+
+// Logs streams the logs of the selected pods of the rocket, interleaved by their timestamps.
+// Structured lines of Rocket.Chat and MongoDB are parsed, lines not matching the filters of the request are dropped.
+// Followed logs drop the lines of pods that are produced faster than the client receives them, which is reported in the stream.
+// Without a pod, followed logs include the pods that start later, e.g. during a rolling upgrade.
+// Otherwise the stream ends after the existing lines were sent.
+func (r *Rocket) Logs(req *rocketpb.LogsRequest, stream rocketpb.RocketService_LogsServer) error {
+	ctx := stream.Context()
+	l := ctxzap.Extract(ctx)
+	filter, err := newLogFilter(req)
+	if err != nil {
+		return err
+	}
+
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return err
+	}
+
+	kubeclient, err := r.clients.KubeClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return err
+	}
+
+	_, err = chatclient.Rockets(req.GetNamespace()).Get(ctx, req.GetName(), metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+		l.Error(err.Error())
+		return err
+	}
+
+	send := func(line k8sutil.LogLine) error {
+		return sendLogLine(stream, line, filter, req.GetTimestamps())
+	}
+	if req.GetFollow() && req.GetPod() == "" {
+		return followLogs(ctx, kubeclient, req, send)
+	}
+
+	podNames, err := selectPods(ctx, kubeclient, req)
+	if err != nil {
+		l.Error(err.Error())
+		return err
+	}
+	l.Debug(fmt.Sprintf("Getting logs from pods %v", podNames))
+
+	opts := podLogOptions(req)
+	// timestamps are needed to interleave the logs of several pods
+	opts.Timestamps = req.GetTimestamps() || len(podNames) > 1
+	muxOpts := k8sutil.LogMuxOptions{
+		DropPolicy:  k8sutil.Block,
+		Timestamps:  opts.Timestamps,
+		MergeWindow: logMergeWindow,
+	}
+	if req.GetFollow() {
+		// slow clients must not stall the kubelet streams forever
+		muxOpts.DropPolicy = k8sutil.DropOldest
+	}
+	mux := k8sutil.NewLogMux(muxOpts)
+	err = k8sutil.AddPodLogs(ctx, kubeclient, req.GetNamespace(), podNames, opts, mux)
+	if err != nil {
+		l.Error(err.Error())
+		return err
+	}
+	mux.Close()
+	return mux.Run(ctx, send)
+}
+
+// followLogs follows the logs of all running pods of the selected components,
+// including the pods that start while the logs are followed, until the stream is canceled.
+func followLogs(ctx context.Context, kubeclient kubernetes.Interface, req *rocketpb.LogsRequest, send func(k8sutil.LogLine) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	opts := podLogOptions(req)
+	opts.Timestamps = true
+	mux := k8sutil.NewLogMux(k8sutil.LogMuxOptions{
+		DropPolicy:  k8sutil.DropOldest,
+		Timestamps:  true,
+		MergeWindow: logMergeWindow,
+		MarkStopped: true,
+	})
+	follower := newPodFollower(kubeclient, req.GetName(), req.GetComponent(), opts, mux)
+	errs := make(chan error, 1)
+	go func() {
+		err := follower.run(ctx, req.GetNamespace())
+		if err != nil {
+			ctxzap.Extract(ctx).Error(err.Error())
+			errs <- err
+			cancel()
+		}
+	}()
+	err := mux.Run(ctx, send)
+	if err != nil {
+		return err
+	}
+	select {
+	case err = <-errs:
+		return err
+	default:
+		return nil
+	}
+}
+
+// selectPods returns the pods of the rocket to read the logs from, selected by the labels set by the operator
+func selectPods(ctx context.Context, kubeclient kubernetes.Interface, req *rocketpb.LogsRequest) ([]string, error) {
+	podClient := kubeclient.CoreV1().Pods(req.GetNamespace())
+	if req.GetPod() != "" {
+		pod, err := podClient.Get(ctx, req.GetPod(), metav1.GetOptions{})
+		if err != nil && !apiErrors.IsNotFound(err) {
+			return nil, fmt.Errorf("Error getting pod %v from cluster api: %w", req.GetPod(), err)
+		}
+		// the pod may be of any component of the rocket
+		if err != nil || !rocketPodSelector(req.GetName(), rocketpb.LogsRequest_COMPONENT_UNSPECIFIED).Matches(labels.Set(pod.Labels)) {
+			return nil, status.Errorf(codes.NotFound, "Pod %v doesn't belong to rocket %v", req.GetPod(), req.GetName())
+		}
+		return []string{pod.Name}, nil
+	}
+
+	pods, err := podClient.List(ctx, metav1.ListOptions{LabelSelector: rocketPodSelector(req.GetName(), req.GetComponent()).String()})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting pods of rocket %v from cluster api: %w", req.GetName(), err)
+	}
+	var podNames []string
+	for _, pod := range pods.Items {
+		podNames = append(podNames, pod.Name)
+	}
+	if len(podNames) == 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "Rocket %v has no pods of component %v", req.GetName(), req.GetComponent())
+	}
+	return podNames, nil
+}
+
+// podLogOptions converts the options of the request to the options of the kubelet
+func podLogOptions(req *rocketpb.LogsRequest) v1.PodLogOptions {
+	opts := v1.PodLogOptions{
+		Container: req.GetContainer(),
+		Follow:    req.GetFollow(),
+		Previous:  req.GetPrevious(),
+	}
+	if req.GetTailLines() > 0 {
+		tailLines := req.GetTailLines()
+		opts.TailLines = &tailLines
+	}
+	switch since := req.GetSince().(type) {
+	case *rocketpb.LogsRequest_SinceSeconds:
+		opts.SinceSeconds = &since.SinceSeconds
+	case *rocketpb.LogsRequest_SinceTime:
+		sinceTime := metav1.NewTime(since.SinceTime.AsTime())
+		opts.SinceTime = &sinceTime
+	}
+	return opts
+}
+
+func sendLogLine(stream rocketpb.RocketService_LogsServer, line k8sutil.LogLine, filter *logFilter, timestamps bool) error {
+	if line.Dropped > 0 {
+		err := stream.Send(&rocketpb.LogsResponse{
+			Message: fmt.Sprintf("%v lines of pod %v dropped", line.Dropped, line.Pod),
+			Pod:     line.Pod,
+			Event:   rocketpb.LogEvent_LOG_EVENT_LINES_DROPPED,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	switch line.Event {
+	case k8sutil.LogEventJoined:
+		return stream.Send(&rocketpb.LogsResponse{Message: fmt.Sprintf("Pod %v joined", line.Pod), Pod: line.Pod, Event: rocketpb.LogEvent_LOG_EVENT_POD_JOINED})
+	case k8sutil.LogEventStopped:
+		return stream.Send(&rocketpb.LogsResponse{Message: fmt.Sprintf("Pod %v stopped", line.Pod), Pod: line.Pod, Event: rocketpb.LogEvent_LOG_EVENT_POD_STOPPED})
+	}
+	if line.Dropped > 0 && line.Message == "" {
+		// only reports the lines dropped at the end of the logs
+		return nil
+	}
+	entry := parseLogLine(line.Message)
+	if !filter.matches(entry) {
+		return nil
+	}
+	resp := entry.toResponse(line.Pod)
+	switch {
+	case !entry.time.IsZero():
+		resp.Timestamp = timestamppb.New(entry.time)
+	case timestamps && !line.Timestamp.IsZero():
+		resp.Timestamp = timestamppb.New(line.Timestamp)
+	}
+	return stream.Send(resp)
+}
+
+// logsArchiveLimit is the maximum size of the logs in an archive
+var logsArchiveLimit int64 = service.MaxLogsArchiveSize
+
+// LogsArchive returns the logs of all containers of the rocket as gzipped tar,
+// including the logs of the previous containers of restarted containers.
+// The archive is built in memory, a ResourceExhausted error is returned if the logs exceed logsArchiveLimit.
+func (r *Rocket) LogsArchive(ctx context.Context, req *rocketpb.LogsArchiveRequest) ([]byte, error) {
+	l := ctxzap.Extract(ctx)
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	kubeclient, err := r.clients.KubeClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	_, err = chatclient.Rockets(req.GetNamespace()).Get(ctx, req.GetName(), metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	pods, err := kubeclient.CoreV1().Pods(req.GetNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: rocketPodSelector(req.GetName(), rocketpb.LogsRequest_COMPONENT_ALL).String(),
+	})
+	if err != nil {
+		err = fmt.Errorf("Error listing pods of rocket: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	l.Debug(fmt.Sprintf("Archiving logs of %v pods", len(pods.Items)))
+
+	var opts v1.PodLogOptions
+	switch since := req.GetSince().(type) {
+	case *rocketpb.LogsArchiveRequest_SinceSeconds:
+		opts.SinceSeconds = &since.SinceSeconds
+	case *rocketpb.LogsArchiveRequest_SinceTime:
+		sinceTime := metav1.NewTime(since.SinceTime.AsTime())
+		opts.SinceTime = &sinceTime
+	}
+	var archive bytes.Buffer
+	err = k8sutil.WritePodLogsArchive(ctx, kubeclient, pods.Items, opts, logsArchiveLimit, &archive)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	return archive.Bytes(), nil
+}
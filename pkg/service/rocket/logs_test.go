@@ -0,0 +1,369 @@
+package rocket
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func logsRocket() chatv1alpha1.Rocket {
+	return chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: TestNamespace},
+		Status: chatv1alpha1.RocketStatus{
+			Pods: []chatv1alpha1.EmbeddedPod{
+				{Name: "foo-rocketchat-6d4cf56db6-7xkq2"},
+				{Name: "foo-rocketchat-6d4cf56db6-p9z8m"},
+				{Name: "foo-mongodb-0"},
+			},
+		},
+	}
+}
+
+// logsPods returns the pods of logsRocket labelled by the operator and a pod only named like them
+func logsPods() []runtime.Object {
+	return []runtime.Object{
+		rocketPod("foo-rocketchat-6d4cf56db6-7xkq2", "foo", "webserver"),
+		rocketPod("foo-rocketchat-6d4cf56db6-p9z8m", "foo", "webserver"),
+		rocketPod("foo-mongodb-0", "foo", "mongodb"),
+		rocketPod("bar-mongodb-0", "bar", "mongodb"),
+		rocketPod("foo-mongodb-backup", "backup", "mongodb"),
+	}
+}
+
+func TestRocket_Logs(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *rocketpb.LogsRequest
+		wantPods []string
+		wantCode codes.Code
+	}{
+		{
+			name:     "single pod",
+			req:      &rocketpb.LogsRequest{Name: "foo", Pod: "foo-mongodb-0"},
+			wantPods: []string{"foo-mongodb-0"},
+		},
+		{
+			name:     "all pods",
+			req:      &rocketpb.LogsRequest{Name: "foo"},
+			wantPods: []string{"foo-mongodb-0", "foo-rocketchat-6d4cf56db6-7xkq2", "foo-rocketchat-6d4cf56db6-p9z8m"},
+		},
+		{
+			name:     "webserver",
+			req:      &rocketpb.LogsRequest{Name: "foo", Component: rocketpb.LogsRequest_COMPONENT_WEBSERVER},
+			wantPods: []string{"foo-rocketchat-6d4cf56db6-7xkq2", "foo-rocketchat-6d4cf56db6-p9z8m"},
+		},
+		{
+			name:     "database",
+			req:      &rocketpb.LogsRequest{Name: "foo", Component: rocketpb.LogsRequest_COMPONENT_DATABASE},
+			wantPods: []string{"foo-mongodb-0"},
+		},
+		{
+			name:     "filtered",
+			req:      &rocketpb.LogsRequest{Name: "foo", Filter: "error"},
+			wantPods: nil,
+		},
+		{
+			name:     "invalid filter",
+			req:      &rocketpb.LogsRequest{Name: "foo", Filter: "(", FilterRegex: true},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "pod of another rocket",
+			req:      &rocketpb.LogsRequest{Name: "foo", Pod: "bar-mongodb-0"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "pod named like the rocket",
+			req:      &rocketpb.LogsRequest{Name: "foo", Pod: "foo-mongodb-backup"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "not existing pod",
+			req:      &rocketpb.LogsRequest{Name: "foo", Pod: "foo-mongodb-1"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name:     "not existing rocket",
+			req:      &rocketpb.LogsRequest{Name: "bar"},
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(logsPods()...), testutils.NewFakeChatClient(logsRocket())))
+			stream := testutils.NewFakeLogsStream(context.Background())
+			tt.req.Namespace = TestNamespace
+			err := s.Logs(tt.req, stream)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			// the stream ends after the existing logs were sent
+			assert.NoError(t, err)
+			var pods []string
+			close(stream.Responses)
+			for resp := range stream.Responses {
+				// the fake clientset returns the same logs for every pod
+				assert.Equal(t, "fake logs", resp.Message)
+				pods = append(pods, resp.Pod)
+			}
+			assert.ElementsMatch(t, tt.wantPods, pods)
+		})
+	}
+}
+
+func TestRocket_Logs_options(t *testing.T) {
+	since := time.Date(2021, 12, 1, 10, 0, 0, 0, time.UTC)
+	tailLines := int64(100)
+	sinceSeconds := int64(60)
+	sinceTime := metav1.NewTime(since)
+	tests := []struct {
+		name string
+		req  *rocketpb.LogsRequest
+		want v1.PodLogOptions
+	}{
+		{
+			name: "single pod",
+			req:  &rocketpb.LogsRequest{Pod: "foo-mongodb-0", Follow: true, Previous: true, Container: "mongodb", TailLines: 100},
+			want: v1.PodLogOptions{Follow: true, Previous: true, Container: "mongodb", TailLines: &tailLines},
+		},
+		{
+			name: "since seconds",
+			req:  &rocketpb.LogsRequest{Pod: "foo-mongodb-0", Timestamps: true, Since: &rocketpb.LogsRequest_SinceSeconds{SinceSeconds: 60}},
+			want: v1.PodLogOptions{Timestamps: true, SinceSeconds: &sinceSeconds},
+		},
+		{
+			name: "since time of all pods",
+			req:  &rocketpb.LogsRequest{Since: &rocketpb.LogsRequest_SinceTime{SinceTime: timestamppb.New(since)}},
+			// several pods are interleaved by their timestamps
+			want: v1.PodLogOptions{Timestamps: true, SinceTime: &sinceTime},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeclient := fake.NewSimpleClientset(logsPods()...)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, testutils.NewFakeChatClient(logsRocket())))
+			tt.req.Name = "foo"
+			tt.req.Namespace = TestNamespace
+			assert.NoError(t, s.Logs(tt.req, testutils.NewFakeLogsStream(context.Background())))
+
+			var logActions int
+			for _, action := range kubeclient.Actions() {
+				if action.GetSubresource() != "log" {
+					continue
+				}
+				logActions++
+				assert.Equal(t, &tt.want, action.(k8stesting.GenericActionImpl).Value)
+			}
+			assert.NotZero(t, logActions)
+		})
+	}
+}
+
+func rocketPod(name, app, component string) *v1.Pod {
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: TestNamespace,
+			UID:       types.UID(name),
+			Labels:    map[string]string{"app": app, "component": component},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+}
+
+func receiveLogs(t *testing.T, stream *testutils.FakeLogsStream) *rocketpb.LogsResponse {
+	select {
+	case resp := <-stream.Responses:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for logs")
+		return nil
+	}
+}
+
+func TestRocket_Logs_follow(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset(
+		rocketPod("foo-mongodb-0", "foo", "mongodb"),
+		rocketPod("bar-mongodb-0", "bar", "mongodb"),
+	)
+	watching := make(chan struct{})
+	kubeclient.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		close(watching)
+		return false, nil, nil
+	})
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, testutils.NewFakeChatClient(logsRocket())))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := testutils.NewFakeLogsStream(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.Logs(&rocketpb.LogsRequest{Name: "foo", Namespace: TestNamespace, Follow: true}, stream)
+	}()
+
+	// pods running at the start don't join
+	resp := receiveLogs(t, stream)
+	assert.Equal(t, "fake logs", resp.Message)
+	assert.Equal(t, "foo-mongodb-0", resp.Pod)
+	resp = receiveLogs(t, stream)
+	assert.Equal(t, rocketpb.LogEvent_LOG_EVENT_POD_STOPPED, resp.Event)
+	assert.Equal(t, "foo-mongodb-0", resp.Pod)
+
+	select {
+	case <-watching:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for pod watch")
+	}
+	pending := rocketPod("foo-rocketchat-6d4cf56db6-zt4rw", "foo", "webserver")
+	pending.Status.Phase = v1.PodPending
+	_, err := kubeclient.CoreV1().Pods(TestNamespace).Create(context.TODO(), pending, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	_, err = kubeclient.CoreV1().Pods(TestNamespace).Update(context.TODO(), rocketPod("foo-rocketchat-6d4cf56db6-zt4rw", "foo", "webserver"), metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	// pods join once they are running
+	resp = receiveLogs(t, stream)
+	assert.Equal(t, rocketpb.LogEvent_LOG_EVENT_POD_JOINED, resp.Event)
+	assert.Equal(t, "foo-rocketchat-6d4cf56db6-zt4rw", resp.Pod)
+	resp = receiveLogs(t, stream)
+	assert.Equal(t, "fake logs", resp.Message)
+	resp = receiveLogs(t, stream)
+	assert.Equal(t, rocketpb.LogEvent_LOG_EVENT_POD_STOPPED, resp.Event)
+
+	cancel()
+	assert.NoError(t, <-errs)
+	assert.Empty(t, stream.Responses)
+}
+
+func TestRocket_LogsArchive(t *testing.T) {
+	webserver := rocketPod("foo-rocketchat-6d4cf56db6-7xkq2", "foo", "webserver")
+	webserver.Status.ContainerStatuses = []v1.ContainerStatus{
+		{Name: "rocket", RestartCount: 2, State: v1.ContainerState{Running: &v1.ContainerStateRunning{}}},
+	}
+	database := rocketPod("foo-mongodb-0", "foo", "mongodb")
+	database.Status.ContainerStatuses = []v1.ContainerStatus{
+		{Name: "mongodb", State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{}}},
+	}
+	waiting := rocketPod("foo-rocketchat-6d4cf56db6-p9z8m", "foo", "webserver")
+	waiting.Status.ContainerStatuses = []v1.ContainerStatus{
+		{Name: "rocket", State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{}}},
+	}
+	other := rocketPod("bar-mongodb-0", "bar", "mongodb")
+	other.Status.ContainerStatuses = database.Status.ContainerStatuses
+
+	sinceSeconds := int64(3600)
+	tests := []struct {
+		name      string
+		req       *rocketpb.LogsArchiveRequest
+		wantFiles []string
+		wantOpts  v1.PodLogOptions
+		limit     int64
+		wantCode  codes.Code
+	}{
+		{
+			name: "all logs",
+			req:  &rocketpb.LogsArchiveRequest{Name: "foo"},
+			wantFiles: []string{
+				"foo-mongodb-0/mongodb.log",
+				"foo-rocketchat-6d4cf56db6-7xkq2/rocket.log",
+				"foo-rocketchat-6d4cf56db6-7xkq2/rocket.previous.log",
+			},
+		},
+		{
+			name: "since seconds",
+			req:  &rocketpb.LogsArchiveRequest{Name: "foo", Since: &rocketpb.LogsArchiveRequest_SinceSeconds{SinceSeconds: 3600}},
+			wantFiles: []string{
+				"foo-mongodb-0/mongodb.log",
+				"foo-rocketchat-6d4cf56db6-7xkq2/rocket.log",
+				"foo-rocketchat-6d4cf56db6-7xkq2/rocket.previous.log",
+			},
+			wantOpts: v1.PodLogOptions{SinceSeconds: &sinceSeconds},
+		},
+		{
+			name:     "not existing rocket",
+			req:      &rocketpb.LogsArchiveRequest{Name: "bar"},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "logs exceeding the limit",
+			req:  &rocketpb.LogsArchiveRequest{Name: "foo"},
+			// two of the three files fit
+			limit:    2*int64(len("fake logs")) + 1,
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.limit > 0 {
+				limit := logsArchiveLimit
+				logsArchiveLimit = tt.limit
+				defer func() { logsArchiveLimit = limit }()
+			}
+			kubeclient := fake.NewSimpleClientset(webserver, database, waiting, other)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, testutils.NewFakeChatClient(logsRocket())))
+			tt.req.Namespace = TestNamespace
+			archive, err := s.LogsArchive(context.Background(), tt.req)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			assert.NoError(t, err)
+
+			gz, err := gzip.NewReader(bytes.NewReader(archive))
+			assert.NoError(t, err)
+			files := tar.NewReader(gz)
+			var names []string
+			for {
+				header, err := files.Next()
+				if err == io.EOF {
+					break
+				}
+				assert.NoError(t, err)
+				names = append(names, header.Name)
+				content, err := io.ReadAll(files)
+				assert.NoError(t, err)
+				assert.Equal(t, "fake logs", string(content))
+			}
+			assert.ElementsMatch(t, tt.wantFiles, names)
+
+			var previous int
+			for _, action := range kubeclient.Actions() {
+				if action.GetSubresource() != "log" {
+					continue
+				}
+				opts := *action.(k8stesting.GenericActionImpl).Value.(*v1.PodLogOptions)
+				assert.Equal(t, tt.wantOpts.SinceSeconds, opts.SinceSeconds)
+				assert.NotEmpty(t, opts.Container)
+				// the logs are read until they exceed the limit of the archive
+				if assert.NotNil(t, opts.LimitBytes) {
+					assert.LessOrEqual(t, *opts.LimitBytes, logsArchiveLimit+1)
+				}
+				if opts.Previous {
+					previous++
+				}
+			}
+			// only the restarted container has previous logs
+			assert.Equal(t, 1, previous)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package rocket
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// pageToken is handed out to clients as next_page_token.
+// Besides the continue token of the cluster it keeps the amount of rockets on the previous pages
+// to calculate the total size from the remaining item count.
+// Pages served from the cache only have an offset.
+type pageToken struct {
+	Continue string `json:"continue"`
+	Offset   int64  `json:"offset"`
+}
+
+func (t pageToken) encode() string {
+	if t == (pageToken{}) {
+		return ""
+	}
+	raw, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodePageToken(token string) (pageToken, error) {
+	var t pageToken
+	if token == "" {
+		return t, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(raw, &t); err != nil {
+		return t, err
+	}
+	if t.Offset < 0 {
+		return t, fmt.Errorf("negative offset %v", t.Offset)
+	}
+	return t, nil
+}
+
+// sortRockets sorts the rockets in place by orderBy.
+// The order of the cluster (namespace and name) is kept for unspecified orders and equal keys.
+func sortRockets(rockets []chatv1alpha1.Rocket, orderBy rocketpb.GetAllRequest_OrderBy) {
+	var less func(a, b *chatv1alpha1.Rocket) bool
+	switch orderBy {
+	case rocketpb.GetAllRequest_ORDER_BY_NAME:
+		less = func(a, b *chatv1alpha1.Rocket) bool { return a.Name < b.Name }
+	case rocketpb.GetAllRequest_ORDER_BY_CREATION_TIME:
+		less = func(a, b *chatv1alpha1.Rocket) bool { return a.CreationTimestamp.Before(&b.CreationTimestamp) }
+	case rocketpb.GetAllRequest_ORDER_BY_PHASE:
+		less = func(a, b *chatv1alpha1.Rocket) bool { return a.Status.Phase < b.Status.Phase }
+	default:
+		return
+	}
+	sort.SliceStable(rockets, func(i, j int) bool {
+		return less(&rockets[i], &rockets[j])
+	})
+}
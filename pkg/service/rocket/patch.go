@@ -0,0 +1,104 @@
+package rocket
+
+import (
+	"fmt"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// paths of a CreateRequest that can be changed through an UpdateRequest
+const (
+	pathHost           = "host"
+	pathReplicas       = "replicas"
+	pathDatabaseSize   = "database_size"
+	pathRocketVersion  = "rocket_version"
+	pathMongodbVersion = "mongodb_version"
+	pathEmail          = "email"
+)
+
+var updatablePaths = map[string]bool{
+	pathHost:           true,
+	pathReplicas:       true,
+	pathDatabaseSize:   true,
+	pathRocketVersion:  true,
+	pathMongodbVersion: true,
+	pathEmail:          true,
+}
+
+// updatePaths returns the normalized paths of mask.
+// If mask is nil, the paths of all populated updatable fields of updated are returned.
+func updatePaths(updated *rocketpb.CreateRequest, mask *fieldmaskpb.FieldMask) ([]string, error) {
+	if mask == nil {
+		var paths []string
+		updated.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if name := string(fd.Name()); updatablePaths[name] {
+				paths = append(paths, name)
+			}
+			return true
+		})
+		if len(paths) == 0 {
+			return nil, fmt.Errorf("Update doesn't contain any updatable field")
+		}
+		return paths, nil
+	}
+
+	mask.Normalize()
+	if len(mask.GetPaths()) == 0 {
+		return nil, fmt.Errorf("Update mask can't be empty")
+	}
+	if !mask.IsValid(updated) {
+		return nil, fmt.Errorf("Update mask %v contains unknown fields", mask.GetPaths())
+	}
+	for _, path := range mask.GetPaths() {
+		if !updatablePaths[path] {
+			return nil, fmt.Errorf("Field %v can't be updated", path)
+		}
+	}
+	return mask.GetPaths(), nil
+}
+
+// patchRocket sets the fields of rocket named by paths to the values of updated
+func patchRocket(rocket *chatv1alpha1.Rocket, updated *rocketpb.CreateRequest, paths []string) error {
+	for _, path := range paths {
+		switch path {
+		case pathHost:
+			rocket.Spec.IngressSpec.Host = updated.GetHost()
+		case pathReplicas:
+			rocket.Spec.Replicas = updated.GetReplicas()
+			rocket.Spec.Database.Replicas = updated.GetReplicas()
+		case pathDatabaseSize:
+			if rocket.Spec.Database.StorageSpec == nil {
+				rocket.Spec.Database.StorageSpec = &chatv1alpha1.EmbeddedPersistentVolumeClaim{}
+			}
+			requests := rocket.Spec.Database.StorageSpec.Spec.Resources.Requests
+			if requests == nil {
+				requests = v1.ResourceList{}
+				rocket.Spec.Database.StorageSpec.Spec.Resources.Requests = requests
+			}
+			requests[v1.ResourceStorage] = databaseStorage(updated.GetDatabaseSize())
+		case pathRocketVersion:
+			rocket.Spec.Version = updated.GetRocketVersion()
+		case pathMongodbVersion:
+			rocket.Spec.Database.Version = updated.GetMongodbVersion()
+		case pathEmail:
+			if rocket.Spec.AdminSpec == nil {
+				rocket.Spec.AdminSpec = &chatv1alpha1.RocketAdminSpec{}
+			}
+			rocket.Spec.AdminSpec.Email = updated.GetEmail()
+		default:
+			return fmt.Errorf("Field %v can't be updated", path)
+		}
+	}
+	return nil
+}
+
+// databaseStorage converts the database size in gigabyte to a storage quantity
+func databaseStorage(sizeInGi int64) resource.Quantity {
+	return *resource.NewQuantity(sizeInGi*1024*1024*1024, resource.BinarySI)
+}
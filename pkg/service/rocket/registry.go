@@ -0,0 +1,104 @@
+package rocket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// registryTimeout limits the requests to the registry, including reading the response
+	registryTimeout = 10 * time.Second
+	// tagsTTL is the time the tags of a repository are cached, they're looked up on every create and update of a rocket
+	tagsTTL = 5 * time.Minute
+)
+
+// Registry looks up the available tags of an image repository
+type Registry interface {
+	Tags(ctx context.Context, repo string) ([]string, error)
+}
+
+type dockerHubRegistry struct {
+	url    string
+	client *http.Client
+	ttl    time.Duration
+
+	mu   sync.Mutex
+	tags map[string]cachedTags
+}
+
+// cachedTags are the tags of a repository until expires
+type cachedTags struct {
+	tags    []string
+	expires time.Time
+}
+
+// NewDockerHubRegistry returns a Registry that looks up the tags on hub.docker.com.
+// The tags of every repository are cached for tagsTTL.
+func NewDockerHubRegistry() Registry {
+	return newDockerHubRegistry("https://registry.hub.docker.com/v1/repositories/%v/tags", tagsTTL)
+}
+
+func newDockerHubRegistry(url string, ttl time.Duration) *dockerHubRegistry {
+	return &dockerHubRegistry{
+		url:    url,
+		client: &http.Client{Timeout: registryTimeout},
+		ttl:    ttl,
+		tags:   make(map[string]cachedTags),
+	}
+}
+
+type tag struct {
+	Name string `json:"name"`
+}
+
+// Tags returns the cached tags of repo or requests them from the registry, if they're expired
+func (d *dockerHubRegistry) Tags(ctx context.Context, repo string) ([]string, error) {
+	d.mu.Lock()
+	cached, ok := d.tags[repo]
+	d.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.tags, nil
+	}
+
+	tags, err := d.requestTags(ctx, repo)
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	d.tags[repo] = cachedTags{tags: tags, expires: time.Now().Add(d.ttl)}
+	d.mu.Unlock()
+	return tags, nil
+}
+
+func (d *dockerHubRegistry) requestTags(ctx context.Context, repo string) (tagNames []string, err error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf(d.url, repo), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Registry responded with status %v for repository %v", resp.Status, repo)
+	}
+	bytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var tags []tag
+	err = json.Unmarshal(bytes, &tags)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		tagNames = append(tagNames, tag.Name)
+	}
+	return
+}
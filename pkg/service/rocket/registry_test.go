@@ -0,0 +1,52 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDockerHubRegistry_Tags(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/rocketchat/rocket.chat/tags" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[{"name": "4.0.0"}, {"name": "4.1.0"}]`)
+	}))
+	defer server.Close()
+
+	registry := newDockerHubRegistry(server.URL+"/%v/tags", time.Minute)
+	for i := 0; i < 2; i++ {
+		tags, err := registry.Tags(context.TODO(), "rocketchat/rocket.chat")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"4.0.0", "4.1.0"}, tags)
+	}
+	assert.Equal(t, 1, requests, "the tags are cached")
+
+	_, err := registry.Tags(context.TODO(), "unknown")
+	assert.Error(t, err)
+	_, err = registry.Tags(context.TODO(), "unknown")
+	assert.Error(t, err)
+	assert.Equal(t, 3, requests, "errors aren't cached")
+
+	registry.ttl = 0
+	registry.tags = make(map[string]cachedTags)
+	_, err = registry.Tags(context.TODO(), "rocketchat/rocket.chat")
+	assert.NoError(t, err)
+	_, err = registry.Tags(context.TODO(), "rocketchat/rocket.chat")
+	assert.NoError(t, err)
+	assert.Equal(t, 5, requests, "expired tags are requested again")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = registry.Tags(ctx, "mongo")
+	assert.ErrorIs(t, err, context.Canceled)
+}
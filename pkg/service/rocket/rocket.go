@@ -2,130 +2,291 @@ package rocket
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 
 	"github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
 	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
-	chatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
 	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
 
 	v1 "k8s.io/api/core/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
-	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
-	apiErrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
-	"k8s.io/client-go/kubernetes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/util/retry"
 
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
 )
 
 type Rocket struct {
-	kubeclient kubernetes.Interface
-	chatclient chatClient.ChatV1alpha1Interface
+	clients  k8sutil.ClientFactory
+	registry Registry
+
+	// cache serves the reads of users authorized by the authorizer, if set
+	cache      *k8sutil.RocketCache
+	authorizer k8sutil.Authorizer
+
+	defaultRocketVersion  string
+	defaultMongodbVersion string
+
+	// issuer configures the issuers created for users without an issuer
+	issuer IssuerOptions
+	// defaultIngressClass is used for rockets created without ingress class, instead of the default class of the cluster
+	defaultIngressClass string
+	// hosts checks the hosts of created and updated rockets, if set
+	hosts *HostChecker
 }
 
-func NewRocketServiceImpl(kubeclient kubernetes.Interface, chatclient chatClient.ChatV1alpha1Interface) *Rocket {
-	return &Rocket{
-		kubeclient: kubeclient,
-		chatclient: chatclient,
+// Option configures optional settings of the Rocket service
+type Option func(*Rocket)
+
+// WithDefaultVersions sets the versions used for rockets that are created without a version
+func WithDefaultVersions(rocketVersion, mongodbVersion string) Option {
+	return func(r *Rocket) {
+		r.defaultRocketVersion = rocketVersion
+		r.defaultMongodbVersion = mongodbVersion
 	}
 }
 
-func (r *Rocket) setRocketClientToUserClient(ctx context.Context) error {
-	userToken, err := oauth.GetAuthTokenFromContext(ctx)
-	if err != nil {
-		return fmt.Errorf("Error getting token: %v", err)
+// WithRegistry sets the registry that is used to look up the available versions
+func WithRegistry(registry Registry) Option {
+	return func(r *Rocket) {
+		r.registry = registry
 	}
-	userClient, err := k8sutil.NewChatClientsetFromToken(userToken)
-	if err != nil {
-		return fmt.Errorf("Error creating new chatClient: %v", err)
+}
+
+// WithCache serves Get, GetAll and Status from the cache after the authorizer allowed the user to read the rockets.
+// The cache needs to be running.
+func WithCache(cache *k8sutil.RocketCache, authorizer k8sutil.Authorizer) Option {
+	return func(r *Rocket) {
+		r.cache = cache
+		r.authorizer = authorizer
 	}
-	r.chatclient = userClient
-	return nil
 }
 
-func (r *Rocket) setKubeClientToUserClient(ctx context.Context) error {
-	userToken := ctx.Value(oauth.TokenKey).(string)
-	userClient, err := k8sutil.NewClientsetFromToken(userToken)
-	if err != nil {
-		return fmt.Errorf("Error creating new chatClient: %v", err)
+// NewRocketServiceImpl returns a Rocket service that uses the clients of the factory to serve each request
+func NewRocketServiceImpl(clients k8sutil.ClientFactory, opts ...Option) *Rocket {
+	r := &Rocket{
+		clients:  clients,
+		registry: NewDockerHubRegistry(),
+		issuer:   DefaultIssuerOptions,
 	}
-	r.kubeclient = userClient
-	return nil
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
 }
 
+// Status sends the current status of the rocket followed by every change of it, until the rocket is deleted
+// or the stream is canceled. Watches closed by the cluster are resumed from the last seen resourceVersion.
 func (r *Rocket) Status(name, namespace string, stream rocketpb.RocketService_StatusServer) error {
-	l := ctxzap.Extract(stream.Context())
+	ctx := stream.Context()
+	l := ctxzap.Extract(ctx)
+	if r.cache != nil {
+		return r.cachedStatus(name, namespace, stream)
+	}
 	selectors := fields.SelectorFromSet(fields.Set{
 		"metadata.name":      name,
 		"metadata.namespace": namespace,
 	})
 
-	err := r.setRocketClientToUserClient(stream.Context())
+	chatclient, err := r.clients.ChatClient(ctx)
 	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
 		l.Error(err.Error())
 		return err
 	}
 
-	watcher, err := r.chatclient.Rockets(namespace).Watch(stream.Context(), metav1.ListOptions{FieldSelector: selectors.String()})
+	rocketClient := chatclient.Rockets(namespace)
+	rocket, err := rocketClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+		l.Error(err.Error())
+		return err
+	}
+	err = sendStatus(stream, watch.Added, rocket)
 	if err != nil {
 		return err
 	}
+
+	resourceVersion := rocket.ResourceVersion
 	for {
-		select {
-		case <-stream.Context().Done():
-			return nil
-		default:
-			event := <-watcher.ResultChan()
-			rocket, ok := event.Object.(*chatv1alpha1.Rocket)
-			if !ok {
-				// should never be the case
-				return fmt.Errorf("Watch event is not of type Rocket")
-			}
-			err = stream.Send(&rocketpb.StatusResponse{Status: rocket.Status.Message, Ready: rocket.Status.Ready})
+		resourceVersion, err = watchRockets(ctx, rocketClient, metav1.ListOptions{FieldSelector: selectors.String()}, resourceVersion,
+			func(eventType watch.EventType, rocket *chatv1alpha1.Rocket) (bool, error) {
+				switch eventType {
+				case watch.Added, watch.Modified:
+					return false, sendStatus(stream, eventType, rocket)
+				case watch.Deleted:
+					return true, sendStatus(stream, eventType, rocket)
+				}
+				return false, nil
+			})
+		if !isExpired(err) {
+			return err
+		}
+
+		// the watch can't be resumed, continue from the current state of the rocket
+		l.Debug(fmt.Sprintf("Watch of rocket expired at resourceVersion %v, getting current state", resourceVersion))
+		rocket, err = rocketClient.Get(ctx, name, metav1.GetOptions{})
+		if apiErrors.IsNotFound(err) {
+			return stream.Send(&rocketpb.StatusResponse{Type: rocketpb.EventType_EVENT_TYPE_DELETED})
+		}
+		if err != nil {
+			err = fmt.Errorf("error getting rocket from cluster api: %w", err)
+			l.Error(err.Error())
+			return err
+		}
+		if rocket.ResourceVersion != resourceVersion {
+			err = sendStatus(stream, watch.Modified, rocket)
 			if err != nil {
 				return err
 			}
 		}
+		resourceVersion = rocket.ResourceVersion
 	}
 }
 
-func (r *Rocket) Create(ctx context.Context, host, name, namespace, email, user string, databaseSize int64, replicas int32) error {
+// WatchRockets sends the events of all rockets in the namespace matching the label selector of the request.
+// Without a resourceVersion, the current rockets are sent first. Bookmarks of the cluster are passed on,
+// so clients can resume the watch from the last resourceVersion they received.
+func (r *Rocket) WatchRockets(req *rocketpb.WatchRocketsRequest, stream rocketpb.RocketService_WatchRocketsServer) error {
+	ctx := stream.Context()
 	l := ctxzap.Extract(ctx)
+	if _, err := labels.Parse(req.GetLabelSelector()); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid label selector: %v", err)
+	}
 
-	err := r.setRocketClientToUserClient(ctx)
+	chatclient, err := r.clients.ChatClient(ctx)
 	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
 		l.Error(err.Error())
 		return err
 	}
 
+	rocketClient := chatclient.Rockets(req.GetNamespace())
+	opts := metav1.ListOptions{LabelSelector: req.GetLabelSelector()}
+	resourceVersion := req.GetResourceVersion()
+	if resourceVersion == "" {
+		rockets, err := rocketClient.List(ctx, opts)
+		if err != nil {
+			err = fmt.Errorf("Error getting rocket list from cluster api: %w", err)
+			l.Error(err.Error())
+			return err
+		}
+		for i := range rockets.Items {
+			err = sendRocketEvent(stream, watch.Added, &rockets.Items[i])
+			if err != nil {
+				return err
+			}
+		}
+		resourceVersion = rockets.ResourceVersion
+		err = stream.Send(&rocketpb.WatchRocketsResponse{Type: rocketpb.EventType_EVENT_TYPE_BOOKMARK, ResourceVersion: resourceVersion})
+		if err != nil {
+			return err
+		}
+	}
+
+	resourceVersion, err = watchRockets(ctx, rocketClient, opts, resourceVersion, func(eventType watch.EventType, rocket *chatv1alpha1.Rocket) (bool, error) {
+		return false, sendRocketEvent(stream, eventType, rocket)
+	})
+	if isExpired(err) {
+		return fmt.Errorf("Watch of rockets can't be resumed from resourceVersion %v: %w", resourceVersion, err)
+	}
+	return err
+}
+
+func sendRocketEvent(stream rocketpb.RocketService_WatchRocketsServer, eventType watch.EventType, rocket *chatv1alpha1.Rocket) error {
+	if eventType == watch.Bookmark {
+		return stream.Send(&rocketpb.WatchRocketsResponse{Type: rocketpb.EventType_EVENT_TYPE_BOOKMARK, ResourceVersion: rocket.ResourceVersion})
+	}
+	return stream.Send(&rocketpb.WatchRocketsResponse{
+		Type:            toEventType(eventType),
+		Rocket:          k8sutil.RocketToResponse(rocket),
+		ResourceVersion: rocket.ResourceVersion,
+	})
+}
+
+func sendStatus(stream rocketpb.RocketService_StatusServer, eventType watch.EventType, rocket *chatv1alpha1.Rocket) error {
+	return stream.Send(&rocketpb.StatusResponse{
+		Status:          rocket.Status.Message,
+		Ready:           rocket.Status.Ready,
+		Type:            toEventType(eventType),
+		Phase:           string(rocket.Status.Phase),
+		ResourceVersion: rocket.ResourceVersion,
+	})
+}
+
+// Create creates a rocket and returns it as persisted by the cluster.
+// Without ingress class, the default class of the server or the cluster is used.
+// Hosts that are used by other rockets or ingresses or aren't allowed in the namespace are rejected.
+// The issuer of the certificate of the rocket is created for the user first, unless it exists.
+// On a dry run the rocket is only validated by the cluster and not persisted.
+func (r *Rocket) Create(ctx context.Context, host, name, namespace, email, user, rocketVersion, mongodbVersion, ingressClass string, databaseSize int64, replicas int32, dryRun bool) (*v1alpha1.Rocket, error) {
+	l := ctxzap.Extract(ctx)
+
+	rocketVersion, err := r.resolveVersion(ctx, service.RocketRepository, rocketVersion, r.defaultRocketVersion)
+	if err != nil {
+		return nil, err
+	}
+	mongodbVersion, err = r.resolveVersion(ctx, service.MongodbRepository, mongodbVersion, r.defaultMongodbVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	// the rocket doesn't exist yet, so nothing may use the host
+	err = r.CheckHostAvailability(ctx, host, "", namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	kubeclient, err := r.clients.KubeClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	ingressClass, err = r.resolveIngressClass(ctx, kubeclient, ingressClass)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	annotations := map[string]string{
+		"cert-manager.io/issuer": IssuerName(user),
+	}
+	if ingressClass != "" {
+		annotations[k8sutil.IngressClassAnnotation] = ingressClass
+	}
+
 	rocket := &chatv1alpha1.Rocket{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
 		Spec: chatv1alpha1.RocketSpec{
 			IngressSpec: chatv1alpha1.RocketIngressSpec{
-				Host: host,
-				Annotations: map[string]string{
-					// TODO: Maybe dynamicly get the ingress class
-					"kubernetes.io/ingress.class": "nginx",
-					"cert-manager.io/issuer":      user + "-issuer",
-				},
+				Host:        host,
+				Annotations: annotations,
 			},
 			Replicas: replicas,
+			Version:  rocketVersion,
 			AdminSpec: &chatv1alpha1.RocketAdminSpec{
 				Email:    email,
 				Username: user,
 			},
 			Database: chatv1alpha1.RocketDatabase{
+				Version:  mongodbVersion,
 				Replicas: replicas,
 				StorageSpec: &chatv1alpha1.EmbeddedPersistentVolumeClaim{
 					//TypeMeta: metav1.TypeMeta{Kind: "PersistentVolumeClaim", APIVersion: "v1"},
@@ -133,7 +294,7 @@ func (r *Rocket) Create(ctx context.Context, host, name, namespace, email, user
 						Resources: v1.ResourceRequirements{
 							Requests: v1.ResourceList{
 								// storage in Gi
-								v1.ResourceStorage: *resource.NewQuantity(databaseSize*1024*1024*1024, resource.BinarySI),
+								v1.ResourceStorage: databaseStorage(databaseSize),
 							},
 						},
 					},
@@ -141,69 +302,145 @@ func (r *Rocket) Create(ctx context.Context, host, name, namespace, email, user
 			},
 		},
 	}
+	if !dryRun {
+		certclient, err := r.clients.CertManagerClient(ctx)
+		if err != nil {
+			err = fmt.Errorf("Error creating cert-manager Client for kubernetes from token: %w", err)
+			l.Error(err.Error())
+			return nil, err
+		}
+		err = r.ensureIssuer(ctx, certclient, namespace, user, email, ingressClass)
+		if err != nil {
+			l.Error(err.Error())
+			return nil, err
+		}
+	}
 	l.Info("Creating rocket")
-	_, err = r.chatclient.Rockets(namespace).Create(ctx, rocket, metav1.CreateOptions{})
+	return chatclient.Rockets(namespace).Create(ctx, rocket, metav1.CreateOptions{DryRun: dryRunOption(dryRun)})
+}
+
+// Update applies the fields of the updated rocket named by the update mask to the existing rocket.
+// Conflicting writes to the rocket are retried on the latest resourceVersion.
+// The updated rocket is returned, on a dry run it is only validated by the cluster and not persisted.
+func (r *Rocket) Update(ctx context.Context, req *rocketpb.UpdateRequest) (*v1alpha1.Rocket, error) {
+	l := ctxzap.Extract(ctx)
+
+	updated := req.GetUpdatedRocket()
+	paths, err := updatePaths(updated, req.GetUpdateMask())
 	if err != nil {
-		return err
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, path := range paths {
+		switch path {
+		case pathRocketVersion:
+			_, err = r.resolveVersion(ctx, service.RocketRepository, updated.GetRocketVersion(), "")
+		case pathMongodbVersion:
+			_, err = r.resolveVersion(ctx, service.MongodbRepository, updated.GetMongodbVersion(), "")
+		case pathHost:
+			err = r.CheckHostAvailability(ctx, updated.GetHost(), updated.GetName(), updated.GetNamespace())
+		}
+		if err != nil {
+			return nil, err
+		}
 	}
-	return nil
-}
 
-func (r *Rocket) Update(_ context.Context, req *rocketpb.UpdateRequest) error {
-	panic("not implemented") // TODO: Implement
+	chatclient, err := r.clients.ChatClient(ctx)
+	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+
+	rocketClient := chatclient.Rockets(updated.GetNamespace())
+	l.Info(fmt.Sprintf("Updating rocket fields %v", paths))
+	var rocket *v1alpha1.Rocket
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := rocketClient.Get(ctx, updated.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		err = patchRocket(current, updated, paths)
+		if err != nil {
+			return err
+		}
+		rocket, err = rocketClient.Update(ctx, current, metav1.UpdateOptions{DryRun: dryRunOption(req.GetDryRun())})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rocket, nil
 }
 
-func (r *Rocket) Delete(ctx context.Context, name, namespace string) error {
+// Delete deletes the rocket and the PersistentVolumeClaims of its pods.
+// The last state of the rocket and the names of the deleted claims are returned,
+// on a dry run nothing is deleted.
+func (r *Rocket) Delete(ctx context.Context, name, namespace string, dryRun bool) (*v1alpha1.Rocket, []string, error) {
 	l := ctxzap.Extract(ctx)
-	err := r.setRocketClientToUserClient(ctx)
+	chatclient, err := r.clients.ChatClient(ctx)
 	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
 		l.Error(err.Error())
-		return err
+		return nil, nil, err
 	}
 
-	err = r.setKubeClientToUserClient(ctx)
+	kubeclient, err := r.clients.KubeClient(ctx)
 	if err != nil {
-		err = fmt.Errorf("Error setting kube Client for kubernetes from token: %v", err)
+		err = fmt.Errorf("Error creating kube Client for kubernetes from token: %w", err)
 		l.Error(err.Error())
-		return err
+		return nil, nil, err
 	}
 
-	chatclient := r.chatclient.Rockets(namespace)
-	rocket, err := chatclient.Get(ctx, name, metav1.GetOptions{})
+	rocketClient := chatclient.Rockets(namespace)
+	rocket, err := rocketClient.Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	err = k8sutil.DeleteVolumeClaim(ctx, rocket, namespace, r.kubeclient)
+	opts := metav1.DeleteOptions{DryRun: dryRunOption(dryRun)}
+	claims, err := k8sutil.DeleteVolumeClaim(ctx, rocket, namespace, kubeclient, opts)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	err = r.chatclient.Rockets(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+	err = rocketClient.Delete(ctx, name, opts)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
-	return nil
+	if dryRun {
+		return rocket, claims, nil
+	}
+
+	// rockets with finalizers are still present and marked for deletion
+	deleted, err := rocketClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apiErrors.IsNotFound(err) {
+			return rocket, claims, nil
+		}
+		return nil, nil, err
+	}
+	return deleted, claims, nil
 }
 
 func (r *Rocket) Get(ctx context.Context, name, namespace string) (*v1alpha1.Rocket, error) {
 	l := ctxzap.Extract(ctx)
-	err := r.setRocketClientToUserClient(ctx)
+	if r.cache != nil {
+		err := r.authorizeRockets(ctx, "get", namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return r.cache.GetRocket(namespace, name)
+	}
+	chatclient, err := r.clients.ChatClient(ctx)
 	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
 		l.Error(err.Error())
 		return nil, err
 	}
 
 	// omitting the namespace (having it set to "" will get all rockets from all namespaces)
-	rocket, err := r.chatclient.Rockets(namespace).Get(ctx, name, metav1.GetOptions{})
+	rocket, err := chatclient.Rockets(namespace).Get(ctx, name, metav1.GetOptions{})
 	if err != nil {
-		if apiErrors.IsNotFound(err) {
-			err = fmt.Errorf("Rocket %v in Namespace %v was not found", name, namespace)
-			l.Error(err.Error())
-			return nil, err
-		}
 		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
 		l.Error(err.Error())
 		return nil, err
@@ -211,102 +448,94 @@ func (r *Rocket) Get(ctx context.Context, name, namespace string) (*v1alpha1.Roc
 	return rocket, nil
 }
 
-func (r *Rocket) GetAll(ctx context.Context, namespace string) (*v1alpha1.RocketList, error) {
+// GetAll lists a page of the rockets matching the selectors of the request, sorted by the requested order.
+// Pages of the cluster can only be sorted by namespace and name, other orders are only served from the cache,
+// where all matching rockets are sorted before the page is cut.
+func (r *Rocket) GetAll(ctx context.Context, req *rocketpb.GetAllRequest) (*service.RocketPage, error) {
 	l := ctxzap.Extract(ctx)
 
-	err := r.setRocketClientToUserClient(ctx)
+	token, err := decodePageToken(req.GetPageToken())
 	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
-		l.Error(err.Error())
-		return nil, err
+		return nil, status.Error(codes.InvalidArgument, "Invalid page token")
 	}
-	// omitting the namespace (having it set to "" will get all rockets from all namespaces)
-	rockets, err := r.chatclient.Rockets(namespace).List(ctx, metav1.ListOptions{})
+	selector, err := labels.Parse(req.GetLabelSelector())
 	if err != nil {
-		err = fmt.Errorf("Error getting rocket list from cluster api: %v", err)
-		l.Error(err.Error())
-		return nil, err
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid label selector: %v", err)
 	}
-
-	return rockets, nil
-}
-
-type tag struct {
-	Name string `json:"name"`
-}
-
-func (r *Rocket) AvailableVersions(repo string) (tagNames []string, err error) {
-	url := fmt.Sprintf("https://registry.hub.docker.com/v1/repositories/%v/tags", repo)
-	resp, err := http.Get(url)
-	if err != nil {
-		return nil, err
+	if _, err := fields.ParseSelector(req.GetFieldSelector()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid field selector: %v", err)
 	}
-	bytes, err := ioutil.ReadAll(resp.Body)
+	// field selectors and pages continued from the cluster can only be served by the cluster
+	if r.cache != nil && req.GetFieldSelector() == "" && token.Continue == "" {
+		return r.cachedPage(ctx, req, selector, token)
+	}
+	if req.GetPageSize() > 0 && req.GetOrderBy() != rocketpb.GetAllRequest_ORDER_BY_UNSPECIFIED {
+		// sorting a page of the cluster would only order the rockets within the page
+		return nil, status.Error(codes.InvalidArgument, "Rockets can't be ordered by order_by in pages of the cluster, omit page_size or order_by")
+	}
+
+	chatclient, err := r.clients.ChatClient(ctx)
 	if err != nil {
+		err = fmt.Errorf("Error creating rocket Client for kubernetes from token: %w", err)
+		l.Error(err.Error())
 		return nil, err
 	}
-	var tags []tag
-	err = json.Unmarshal(bytes, &tags)
+	// omitting the namespace (having it set to "" will get all rockets from all namespaces)
+	rockets, err := chatclient.Rockets(req.GetNamespace()).List(ctx, metav1.ListOptions{
+		Limit:         int64(req.GetPageSize()),
+		Continue:      token.Continue,
+		LabelSelector: req.GetLabelSelector(),
+		FieldSelector: req.GetFieldSelector(),
+	})
 	if err != nil {
+		err = fmt.Errorf("Error getting rocket list from cluster api: %w", err)
+		l.Error(err.Error())
 		return nil, err
 	}
-	for _, tag := range tags {
-		tagNames = append(tagNames, tag.Name)
+
+	sortRockets(rockets.Items, req.GetOrderBy())
+	page := &service.RocketPage{Rockets: rockets.Items}
+	switch {
+	case rockets.Continue == "":
+		// last page
+		page.TotalSize = token.Offset + int64(len(rockets.Items))
+		return page, nil
+	case rockets.RemainingItemCount != nil:
+		page.TotalSize = token.Offset + int64(len(rockets.Items)) + *rockets.RemainingItemCount
 	}
-	return
+	page.NextPageToken = pageToken{
+		Continue: rockets.Continue,
+		Offset:   token.Offset + int64(len(rockets.Items)),
+	}.encode()
+	return page, nil
 }
 
-func (r *Rocket) Logs(name, namespace, pod string, stream rocketpb.RocketService_LogsServer) error {
-	l := ctxzap.Extract(stream.Context())
-
-	err := r.setRocketClientToUserClient(stream.Context())
-	if err != nil {
-		err = fmt.Errorf("Error setting rocket Client for kubernetes from token: %v", err)
-		l.Error(err.Error())
-		return err
+// dryRunOption returns the DryRun value of the options for mutating requests to the cluster
+func dryRunOption(dryRun bool) []string {
+	if dryRun {
+		return []string{metav1.DryRunAll}
 	}
+	return nil
+}
 
-	err = r.setKubeClientToUserClient(stream.Context())
-	if err != nil {
-		err = fmt.Errorf("Error setting kube Client for kubernetes from token: %v", err)
-		l.Error(err.Error())
-		return err
-	}
+func (r *Rocket) AvailableVersions(ctx context.Context, repo string) ([]string, error) {
+	return r.registry.Tags(ctx, repo)
+}
 
-	rocket, err := r.chatclient.Rockets(namespace).Get(stream.Context(), name, metav1.GetOptions{})
+// resolveVersion returns the defaultVersion if version is empty.
+// Otherwise version must match an available tag of the repository.
+func (r *Rocket) resolveVersion(ctx context.Context, repo, version, defaultVersion string) (string, error) {
+	if version == "" {
+		return defaultVersion, nil
+	}
+	tags, err := r.registry.Tags(ctx, repo)
 	if err != nil {
-		if apiErrors.IsNotFound(err) {
-			err = fmt.Errorf("Rocket %v in Namespace %v was not found", name, namespace)
-			l.Error(err.Error())
-			return err
-		}
-		err = fmt.Errorf("error getting rocket from cluster api: %w", err)
-		l.Error(err.Error())
-		return err
+		return "", fmt.Errorf("Error getting available versions of %v: %w", repo, err)
 	}
-
-	errChan := make(chan error, 0)
-
-	if pod != "" {
-		l.Debug(fmt.Sprintf("Getting logs from pod %v", pod))
-		k8sutil.GetPodLogs(r.kubeclient, []string{pod}, namespace, stream, errChan)
-	} else {
-		var podNames []string
-		for _, pod := range rocket.Status.Pods {
-			podNames = append(podNames, pod.Name)
+	for _, tag := range tags {
+		if tag == version {
+			return version, nil
 		}
-		l.Debug("Getting logs from all pods")
-		k8sutil.GetPodLogs(r.kubeclient, podNames, namespace, stream, errChan)
-	}
-	// wait for stream context to close
-	l.Debug("Waiting for grpc context to close")
-	select {
-	case <-stream.Context().Done():
-		l.Debug("Context done!")
-		return nil
-	case err = <-errChan:
-		l.Debug("Error recieving pod logs in routines")
-		return err
 	}
-
+	return "", status.Errorf(codes.InvalidArgument, "Version %v is not available for %v", version, repo)
 }
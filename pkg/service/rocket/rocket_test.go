@@ -3,15 +3,28 @@ package rocket
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
 	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
 	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
 	chatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	fakeChatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1/fake"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/retry"
 )
 
 const TestNamespace string = "test-ns"
@@ -20,21 +33,193 @@ func TestRocket_Update(t *testing.T) {
 	type faked struct {
 		rocket chatv1alpha1.Rocket
 	}
+	existing := chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: TestNamespace,
+		},
+		Spec: chatv1alpha1.RocketSpec{
+			Replicas:  1,
+			AdminSpec: &chatv1alpha1.RocketAdminSpec{Email: "old@example.com"},
+			Database:  chatv1alpha1.RocketDatabase{Version: "4.4.10"},
+			Version:   "3.18.0",
+			IngressSpec: chatv1alpha1.RocketIngressSpec{
+				Host: "old.example.com",
+			},
+		},
+	}
 	tests := []struct {
-		name    string
-		faked   faked
-		want    *rocketpb.UpdateResponse
-		wantErr bool
+		name         string
+		faked        faked
+		req          *rocketpb.UpdateRequest
+		conflicts    int
+		wantHost     string
+		wantReplicas int32
+		wantUpdates  int
+		wantCode     codes.Code
+		wantErr      bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "non updatable field",
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace, User: "bar"},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"user"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"foo"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "nothing to update",
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace},
+			},
+			wantErr: true,
+		},
+		{
+			name: "non existing rocket",
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace, Host: "new.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "update host and replicas",
+			faked: faked{
+				rocket: existing,
+			},
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace, Host: "new.example.com", Replicas: 3, Email: "new@example.com"},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host", "replicas"}},
+			},
+			wantHost:     "new.example.com",
+			wantReplicas: 3,
+			wantUpdates:  1,
+		},
+		{
+			name: "update host with conflict",
+			faked: faked{
+				rocket: existing,
+			},
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace, Host: "new.example.com", Replicas: 3},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host"}},
+			},
+			conflicts:    2,
+			wantHost:     "new.example.com",
+			wantReplicas: 1,
+			wantUpdates:  3,
+		},
+		{
+			name: "conflicts exceeding the retries",
+			faked: faked{
+				rocket: existing,
+			},
+			req: &rocketpb.UpdateRequest{
+				UpdatedRocket: &rocketpb.CreateRequest{Name: "foo", Namespace: TestNamespace, Host: "new.example.com"},
+				UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"host"}},
+			},
+			conflicts:   10,
+			wantUpdates: retry.DefaultRetry.Steps,
+			wantCode:    codes.FailedPrecondition,
+			wantErr:     true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient(tt.faked.rocket))
-			err := s.Update(context.TODO(), nil)
+			chatclient := testutils.NewFakeChatClient(tt.faked.rocket)
+			conflicts := tt.conflicts
+			updates := 0
+			chatclient.(*fakeChatClient.FakeChatV1alpha1).PrependReactor("update", "rockets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				updates++
+				if conflicts > 0 {
+					conflicts--
+					return true, nil, apiErrors.NewConflict(chatv1alpha1.SchemeGroupVersion.WithResource("rockets").GroupResource(), "foo", assert.AnError)
+				}
+				return false, nil, nil
+			})
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+			updated, err := s.Update(context.TODO(), tt.req)
+			// conflicting updates are retried with the current rocket
+			assert.Equal(t, tt.wantUpdates, updates)
 			if tt.wantErr {
 				assert.Error(t, err)
+				if tt.wantCode != codes.OK {
+					assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				}
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantHost, updated.Spec.IngressSpec.Host)
+			rocket, err := chatclient.Rockets(TestNamespace).Get(context.TODO(), "foo", metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantHost, rocket.Spec.IngressSpec.Host)
+			assert.Equal(t, tt.wantReplicas, rocket.Spec.Replicas)
+			// fields that are not part of the update mask are unchanged
+			assert.Equal(t, existing.Spec.AdminSpec, rocket.Spec.AdminSpec)
+			assert.Equal(t, existing.Spec.Database.Version, rocket.Spec.Database.Version)
+			assert.Equal(t, existing.Spec.Version, rocket.Spec.Version)
+		})
+	}
+}
+
+func TestPatchRocket(t *testing.T) {
+	updated := &rocketpb.CreateRequest{
+		Host:           "new.example.com",
+		Replicas:       3,
+		DatabaseSize:   5,
+		RocketVersion:  "4.0.0",
+		MongodbVersion: "5.0.0",
+		Email:          "new@example.com",
+	}
+	tests := []struct {
+		name   string
+		paths  []string
+		assert func(t *testing.T, rocket *chatv1alpha1.Rocket)
+	}{
+		{
+			name:  "host only",
+			paths: []string{"host"},
+			assert: func(t *testing.T, rocket *chatv1alpha1.Rocket) {
+				assert.Equal(t, "new.example.com", rocket.Spec.IngressSpec.Host)
+				assert.Equal(t, int32(1), rocket.Spec.Replicas)
+				assert.Equal(t, "3.18.2", rocket.Spec.Version)
+			},
+		},
+		{
+			name:  "all fields",
+			paths: []string{"host", "replicas", "database_size", "rocket_version", "mongodb_version", "email"},
+			assert: func(t *testing.T, rocket *chatv1alpha1.Rocket) {
+				assert.Equal(t, "new.example.com", rocket.Spec.IngressSpec.Host)
+				assert.Equal(t, int32(3), rocket.Spec.Replicas)
+				assert.Equal(t, int32(3), rocket.Spec.Database.Replicas)
+				assert.Equal(t, "5Gi", rocket.Spec.Database.StorageSpec.Spec.Resources.Requests.Storage().String())
+				assert.Equal(t, "4.0.0", rocket.Spec.Version)
+				assert.Equal(t, "5.0.0", rocket.Spec.Database.Version)
+				assert.Equal(t, "new@example.com", rocket.Spec.AdminSpec.Email)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rocket := &chatv1alpha1.Rocket{
+				Spec: chatv1alpha1.RocketSpec{
+					Replicas: 1,
+					Version:  "3.18.2",
+					IngressSpec: chatv1alpha1.RocketIngressSpec{
+						Host: "old.example.com",
+					},
+				},
+			}
+			err := patchRocket(rocket, updated, tt.paths)
+			assert.NoError(t, err)
+			tt.assert(t, rocket)
 		})
 	}
 }
@@ -43,26 +228,102 @@ func TestRocket_Delete(t *testing.T) {
 	type args struct {
 		name      string
 		namespace string
+		dryRun    bool
 	}
 	type faked struct {
 		rocket chatv1alpha1.Rocket
+		pods   []runtime.Object
+	}
+	rocket := chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: TestNamespace,
+		},
+		Status: chatv1alpha1.RocketStatus{
+			Pods: []chatv1alpha1.EmbeddedPod{{Name: "foo-mongodb-0"}, {Name: "foo-rocketchat-0"}},
+		},
+	}
+	pods := []runtime.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo-mongodb-0", Namespace: TestNamespace},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: "datadir",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "datadir-foo-mongodb-0"},
+				},
+			}}},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "foo-rocketchat-0", Namespace: TestNamespace}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "datadir-foo-mongodb-0", Namespace: TestNamespace}},
 	}
 	tests := []struct {
-		name    string
-		args    args
-		faked   faked
-		want    *rocketpb.DeleteResponse
-		wantErr bool
+		name       string
+		args       args
+		faked      faked
+		wantClaims []string
+		wantErr    bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "existing rocket",
+			args: args{
+				name:      "foo",
+				namespace: TestNamespace,
+			},
+			faked: faked{
+				rocket: chatv1alpha1.Rocket{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "foo",
+						Namespace: TestNamespace,
+					},
+				},
+			},
+		},
+		{
+			name: "volume claims of the pods",
+			args: args{
+				name:      "foo",
+				namespace: TestNamespace,
+			},
+			faked: faked{
+				rocket: rocket,
+				pods:   pods,
+			},
+			wantClaims: []string{"datadir-foo-mongodb-0"},
+		},
+		{
+			name: "dry run",
+			args: args{
+				name:      "foo",
+				namespace: TestNamespace,
+				dryRun:    true,
+			},
+			faked: faked{
+				rocket: rocket,
+				pods:   pods,
+			},
+			wantClaims: []string{"datadir-foo-mongodb-0"},
+		},
+		{
+			name: "non existing rocket",
+			args: args{
+				name:      "foo",
+				namespace: TestNamespace,
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient(tt.faked.rocket))
-			err := s.Delete(context.TODO(), tt.args.name, tt.args.namespace)
+			kubeclient := fake.NewSimpleClientset(tt.faked.pods...)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, testutils.NewFakeChatClient(tt.faked.rocket)))
+			deleted, claims, err := s.Delete(context.TODO(), tt.args.name, tt.args.namespace, tt.args.dryRun)
 			if tt.wantErr {
 				assert.Error(t, err)
+				return
 			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.args.name, deleted.Name)
+			assert.Equal(t, tt.wantClaims, claims)
 		})
 	}
 }
@@ -88,7 +349,8 @@ func TestRocket_Get(t *testing.T) {
 			faked: faked{
 				rocket: chatv1alpha1.Rocket{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "bar",
+						Name:      "bar",
+						Namespace: TestNamespace,
 					},
 				},
 			},
@@ -104,10 +366,12 @@ func TestRocket_Get(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient(tt.faked.rocket))
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), testutils.NewFakeChatClient(tt.faked.rocket)))
 			rocket, err := s.Get(context.TODO(), tt.args.name, TestNamespace)
 			if tt.wantErr {
 				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
 			}
 			if rocket != nil {
 				assert.Equal(t, tt.faked.rocket.Name, rocket.Name)
@@ -118,76 +382,147 @@ func TestRocket_Get(t *testing.T) {
 }
 
 func TestRocket_GetAll(t *testing.T) {
-	type faked struct {
-		rockets []chatv1alpha1.Rocket
+	created := metav1.Now()
+	rockets := []chatv1alpha1.Rocket{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "foo",
+				Namespace:         TestNamespace,
+				Labels:            map[string]string{"tier": "free"},
+				CreationTimestamp: metav1.NewTime(created.Add(time.Hour)),
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "bar",
+				Namespace:         TestNamespace,
+				Labels:            map[string]string{"tier": "paid"},
+				CreationTimestamp: created,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "baz",
+				Namespace: "other-ns",
+			},
+		},
 	}
 	tests := []struct {
-		name    string
-		faked   faked
-		wantErr bool
+		name      string
+		req       *rocketpb.GetAllRequest
+		want      []string
+		wantTotal int64
+		wantErr   bool
 	}{
 		{
-			name: "two rockets",
-			faked: faked{
-				rockets: []chatv1alpha1.Rocket{
-					{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "foo",
-							Namespace: TestNamespace,
-						},
-					},
-					{
-						ObjectMeta: metav1.ObjectMeta{
-							Name:      "bar",
-							Namespace: TestNamespace,
-						},
-					},
-				},
-			},
+			name:      "namespace",
+			req:       &rocketpb.GetAllRequest{Namespace: TestNamespace},
+			want:      []string{TestNamespace + "/bar", TestNamespace + "/foo"},
+			wantTotal: 2,
+		},
+		{
+			name:      "all namespaces",
+			req:       &rocketpb.GetAllRequest{},
+			want:      []string{"other-ns/baz", TestNamespace + "/bar", TestNamespace + "/foo"},
+			wantTotal: 3,
+		},
+		{
+			name:      "label selector",
+			req:       &rocketpb.GetAllRequest{Namespace: TestNamespace, LabelSelector: "tier=free"},
+			want:      []string{TestNamespace + "/foo"},
+			wantTotal: 1,
+		},
+		{
+			name:      "order by creation time",
+			req:       &rocketpb.GetAllRequest{Namespace: TestNamespace, OrderBy: rocketpb.GetAllRequest_ORDER_BY_CREATION_TIME},
+			want:      []string{TestNamespace + "/bar", TestNamespace + "/foo"},
+			wantTotal: 2,
+		},
+		{
+			name:    "order by with pages of the cluster",
+			req:     &rocketpb.GetAllRequest{Namespace: TestNamespace, PageSize: 1, OrderBy: rocketpb.GetAllRequest_ORDER_BY_NAME},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label selector",
+			req:     &rocketpb.GetAllRequest{LabelSelector: "tier in free"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid page token",
+			req:     &rocketpb.GetAllRequest{PageToken: "foo"},
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient(tt.faked.rockets...))
-			rockets, err := s.GetAll(context.TODO(), TestNamespace)
-			if err != nil && tt.wantErr {
-				t.Fatalf("Error on getAll")
-			} else if tt.wantErr {
-				assert.Error(t, err)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), testutils.NewFakeChatClient(rockets...)))
+			page, err := s.GetAll(context.TODO(), tt.req)
+			if tt.wantErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				return
 			}
-			for _, actual := range rockets.Items {
-				for _, expected := range tt.faked.rockets {
-					assert.Equal(t, expected.Name, actual.Name)
-					assert.Equal(t, expected.Namespace, actual.Namespace)
-				}
+			assert.NoError(t, err)
+			var actual []string
+			for _, rocket := range page.Rockets {
+				actual = append(actual, rocket.Namespace+"/"+rocket.Name)
 			}
-
+			if tt.req.GetOrderBy() == rocketpb.GetAllRequest_ORDER_BY_UNSPECIFIED {
+				assert.ElementsMatch(t, tt.want, actual)
+			} else {
+				assert.Equal(t, tt.want, actual)
+			}
+			assert.Equal(t, tt.wantTotal, page.TotalSize)
+			assert.Empty(t, page.NextPageToken)
 		})
 	}
 }
 
-func TestRocket_Logs(t *testing.T) {
-	type args struct {
-		name   string
-		pod    string
-		stream rocketpb.RocketService_LogsServer
-	}
-	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
-	}{
-		// TODO: Add test cases.
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient())
-			err := s.Logs(tt.args.name, TestNamespace, tt.args.pod, nil)
-			if tt.wantErr {
-				assert.Error(t, err)
-			}
-		})
+func TestRocket_GetAll_pages(t *testing.T) {
+	remaining := int64(1)
+	pages := []*chatv1alpha1.RocketList{
+		{
+			ListMeta: metav1.ListMeta{Continue: "page-2", RemainingItemCount: &remaining},
+			Items: []chatv1alpha1.Rocket{
+				{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: TestNamespace}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "baz", Namespace: TestNamespace}},
+			},
+		},
+		{
+			Items: []chatv1alpha1.Rocket{
+				{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: TestNamespace}},
+			},
+		},
 	}
+	chatclient := testutils.NewFakeChatClient()
+	// the fake clientset doesn't support limits, the cluster responses are served in order instead
+	chatclient.(*fakeChatClient.FakeChatV1alpha1).PrependReactor("list", "rockets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		page := pages[0]
+		pages = pages[1:]
+		return true, page, nil
+	})
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+
+	page, err := s.GetAll(context.TODO(), &rocketpb.GetAllRequest{Namespace: TestNamespace, PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.Rockets, 2)
+	assert.Equal(t, int64(3), page.TotalSize)
+	token, err := decodePageToken(page.NextPageToken)
+	assert.NoError(t, err)
+	assert.Equal(t, pageToken{Continue: "page-2", Offset: 2}, token)
+
+	page, err = s.GetAll(context.TODO(), &rocketpb.GetAllRequest{Namespace: TestNamespace, PageSize: 2, PageToken: page.NextPageToken})
+	assert.NoError(t, err)
+	assert.Len(t, page.Rockets, 1)
+	assert.Equal(t, int64(3), page.TotalSize)
+	assert.Empty(t, page.NextPageToken)
+}
+
+// fakeRegistry maps repositories to their available tags
+type fakeRegistry map[string][]string
+
+func (f fakeRegistry) Tags(ctx context.Context, repo string) ([]string, error) {
+	return f[repo], nil
 }
 
 func TestRocket_Create(t *testing.T) {
@@ -196,29 +531,150 @@ func TestRocket_Create(t *testing.T) {
 		chatclient chatClient.ChatV1alpha1Interface
 	}
 	type args struct {
-		ctx          context.Context
-		name         string
-		namespace    string
-		user         string
-		host         string
-		email        string
-		databaseSize int64
-		replicas     int32
+		ctx            context.Context
+		name           string
+		namespace      string
+		user           string
+		host           string
+		email          string
+		rocketVersion  string
+		mongodbVersion string
+		ingressClass   string
+		databaseSize   int64
+		replicas       int32
+		dryRun         bool
+	}
+	registry := fakeRegistry{
+		service.RocketRepository:  {"3.18.2", "4.0.0"},
+		service.MongodbRepository: {"4.4.10"},
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
+		name         string
+		fields       fields
+		args         args
+		defaultClass string
+		wantClass    string
+		wantErr      bool
 	}{
-		// TODO: Add test cases.
+		{
+			name: "unknown rocket version",
+			args: args{
+				ctx:           context.TODO(),
+				name:          "foo",
+				namespace:     TestNamespace,
+				rocketVersion: "0.0.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown mongodb version",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "0.0.1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "available versions",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				host:           "chat.example.com",
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "4.4.10",
+				databaseSize:   1,
+				replicas:       1,
+			},
+			wantClass: "nginx",
+		},
+		{
+			name: "dry run",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				host:           "chat.example.com",
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "4.4.10",
+				databaseSize:   1,
+				replicas:       1,
+				dryRun:         true,
+			},
+			wantClass: "nginx",
+		},
+		{
+			name: "requested ingress class",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				host:           "chat.example.com",
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "4.4.10",
+				ingressClass:   "traefik",
+				databaseSize:   1,
+				replicas:       1,
+			},
+			wantClass: "traefik",
+		},
+		{
+			name: "unknown ingress class",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				host:           "chat.example.com",
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "4.4.10",
+				ingressClass:   "haproxy",
+				databaseSize:   1,
+				replicas:       1,
+			},
+			wantErr: true,
+		},
+		{
+			name: "default ingress class of the server",
+			args: args{
+				ctx:            context.TODO(),
+				name:           "foo",
+				namespace:      TestNamespace,
+				host:           "chat.example.com",
+				rocketVersion:  "4.0.0",
+				mongodbVersion: "4.4.10",
+				databaseSize:   1,
+				replicas:       1,
+			},
+			defaultClass: "traefik",
+			wantClass:    "traefik",
+		},
 	}
+	traefik := &networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "traefik"}}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewRocketServiceImpl(fake.NewSimpleClientset(), testutils.NewFakeChatClient())
-			if err := s.Create(tt.args.ctx, tt.args.host, tt.args.name, tt.args.namespace, tt.args.user, tt.args.email, tt.args.databaseSize, tt.args.replicas); (err != nil) && tt.wantErr {
-				t.Fatalf("Error on create: %v", err)
+			chatclient := testutils.NewFakeChatClient()
+			kubeclient := fake.NewSimpleClientset(defaultIngressClass("nginx"), traefik)
+			s := NewRocketServiceImpl(testutils.NewFakeClientFactory(kubeclient, chatclient), WithRegistry(registry), WithDefaultIngressClass(tt.defaultClass))
+			created, err := s.Create(tt.args.ctx, tt.args.host, tt.args.name, tt.args.namespace, tt.args.user, tt.args.email, tt.args.rocketVersion, tt.args.mongodbVersion, tt.args.ingressClass, tt.args.databaseSize, tt.args.replicas, tt.args.dryRun)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.args.host, created.Spec.IngressSpec.Host)
+			assert.Equal(t, tt.args.rocketVersion, created.Spec.Version)
+			assert.Equal(t, tt.wantClass, created.Spec.IngressSpec.Annotations["kubernetes.io/ingress.class"])
+			if tt.args.dryRun {
+				// the fake clientset doesn't support dry runs, only the returned rocket can be checked
+				return
 			}
+			rocket, err := chatclient.Rockets(tt.args.namespace).Get(tt.args.ctx, tt.args.name, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.args.rocketVersion, rocket.Spec.Version)
+			assert.Equal(t, tt.args.mongodbVersion, rocket.Spec.Database.Version)
 		})
 	}
 }
@@ -0,0 +1,104 @@
+package rocket
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	chatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+)
+
+// watchRetryDelay is the time to wait before a watch that was closed without any event is re-established
+var watchRetryDelay = time.Second
+
+// rocketHandler handles an event of a rocket watch and reports if the watch is done
+type rocketHandler func(eventType watch.EventType, rocket *chatv1alpha1.Rocket) (done bool, err error)
+
+// watchRockets passes the events of the rockets selected by opts after resourceVersion to handle,
+// until handle is done or ctx is canceled. Bookmarks are passed to handle as well.
+// Watches closed by the cluster are re-established from the last seen resourceVersion, which is returned.
+// If the resourceVersion is too old to resume, an error is returned for which isExpired is true.
+func watchRockets(ctx context.Context, rockets chatClient.RocketInterface, opts metav1.ListOptions, resourceVersion string, handle rocketHandler) (string, error) {
+	opts.AllowWatchBookmarks = true
+	for {
+		opts.ResourceVersion = resourceVersion
+		watcher, err := rockets.Watch(ctx, opts)
+		if err != nil {
+			if ctx.Err() != nil {
+				return resourceVersion, nil
+			}
+			return resourceVersion, err
+		}
+
+		lastResourceVersion, done, err := consumeRocketEvents(ctx, watcher, resourceVersion, handle)
+		if done || err != nil {
+			return lastResourceVersion, err
+		}
+		if lastResourceVersion == resourceVersion {
+			// don't hammer the cluster with watches that are closed right away
+			select {
+			case <-ctx.Done():
+				return resourceVersion, nil
+			case <-time.After(watchRetryDelay):
+			}
+		}
+		resourceVersion = lastResourceVersion
+	}
+}
+
+// consumeRocketEvents passes the events of watcher to handle until the watch is closed.
+// The watcher is stopped on return.
+func consumeRocketEvents(ctx context.Context, watcher watch.Interface, resourceVersion string, handle rocketHandler) (string, bool, error) {
+	defer watcher.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return resourceVersion, true, nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return resourceVersion, false, nil
+			}
+			if event.Type == watch.Error {
+				return resourceVersion, false, apiErrors.FromObject(event.Object)
+			}
+			rocket, ok := event.Object.(*chatv1alpha1.Rocket)
+			if !ok {
+				return resourceVersion, false, fmt.Errorf("Watch event is not of type Rocket but %T", event.Object)
+			}
+			if rocket.ResourceVersion != "" {
+				resourceVersion = rocket.ResourceVersion
+			}
+			done, err := handle(event.Type, rocket)
+			if done || err != nil {
+				return resourceVersion, done, err
+			}
+		}
+	}
+}
+
+// isExpired reports if err was caused by a resourceVersion that is too old to resume a watch
+func isExpired(err error) bool {
+	return apiErrors.IsResourceExpired(err) || apiErrors.IsGone(err)
+}
+
+// toEventType converts the type of a watch event to the type sent to clients
+func toEventType(eventType watch.EventType) rocketpb.EventType {
+	switch eventType {
+	case watch.Added:
+		return rocketpb.EventType_EVENT_TYPE_ADDED
+	case watch.Modified:
+		return rocketpb.EventType_EVENT_TYPE_MODIFIED
+	case watch.Deleted:
+		return rocketpb.EventType_EVENT_TYPE_DELETED
+	case watch.Bookmark:
+		return rocketpb.EventType_EVENT_TYPE_BOOKMARK
+	default:
+		return rocketpb.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
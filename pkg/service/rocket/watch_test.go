@@ -0,0 +1,229 @@
+package rocket
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	fakeChatClient "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1/fake"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func statusRocket(resourceVersion, message string) *chatv1alpha1.Rocket {
+	return &chatv1alpha1.Rocket{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo",
+			Namespace:       TestNamespace,
+			ResourceVersion: resourceVersion,
+		},
+		Status: chatv1alpha1.RocketStatus{Message: message},
+	}
+}
+
+// fakeWatches serves the watches of rockets from watchers that are controlled by the test
+type fakeWatches struct {
+	watchers chan *watch.FakeWatcher
+	// resourceVersions are the resourceVersions the watches were started from
+	resourceVersions chan string
+}
+
+func newFakeWatches(chatclient interface{}) *fakeWatches {
+	w := &fakeWatches{
+		watchers:         make(chan *watch.FakeWatcher, 4),
+		resourceVersions: make(chan string, 4),
+	}
+	chatclient.(*fakeChatClient.FakeChatV1alpha1).PrependWatchReactor("rockets", func(action k8stesting.Action) (bool, watch.Interface, error) {
+		watcher := watch.NewFakeWithChanSize(4, false)
+		w.resourceVersions <- action.(k8stesting.WatchActionImpl).WatchRestrictions.ResourceVersion
+		w.watchers <- watcher
+		return true, watcher, nil
+	})
+	return w
+}
+
+func (w *fakeWatches) next(t *testing.T) (*watch.FakeWatcher, string) {
+	select {
+	case watcher := <-w.watchers:
+		return watcher, <-w.resourceVersions
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for watch")
+		return nil, ""
+	}
+}
+
+func receiveStatus(t *testing.T, stream *testutils.FakeStatusStream) *rocketpb.StatusResponse {
+	select {
+	case resp := <-stream.Responses:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for status")
+		return nil
+	}
+}
+
+func runStatus(s *Rocket, stream *testutils.FakeStatusStream) chan error {
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.Status("foo", TestNamespace, stream)
+	}()
+	return errs
+}
+
+func TestRocket_Status(t *testing.T) {
+	chatclient := testutils.NewFakeChatClient(*statusRocket("1", "Creating"))
+	watches := newFakeWatches(chatclient)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+	stream := testutils.NewFakeStatusStream(context.Background())
+	errs := runStatus(s, stream)
+
+	// the current state is sent before anything changes
+	resp := receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_ADDED, resp.Type)
+	assert.Equal(t, "Creating", resp.Status)
+
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "1", resourceVersion)
+	watcher.Modify(statusRocket("2", "Running"))
+	resp = receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_MODIFIED, resp.Type)
+	assert.Equal(t, "Running", resp.Status)
+
+	// closed watches are resumed from the last resourceVersion
+	watcher.Action(watch.Bookmark, statusRocket("3", ""))
+	watcher.Stop()
+	watcher, resourceVersion = watches.next(t)
+	assert.Equal(t, "3", resourceVersion)
+
+	watcher.Delete(statusRocket("4", "Running"))
+	resp = receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_DELETED, resp.Type)
+	assert.NoError(t, <-errs)
+}
+
+func TestRocket_Status_expired(t *testing.T) {
+	chatclient := testutils.NewFakeChatClient(*statusRocket("1", "Creating"))
+	watches := newFakeWatches(chatclient)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+	stream := testutils.NewFakeStatusStream(context.Background())
+	errs := runStatus(s, stream)
+	receiveStatus(t, stream)
+
+	// the rocket changed while the resourceVersion expired
+	_, err := chatclient.Rockets(TestNamespace).Update(context.TODO(), statusRocket("5", "Running"), metav1.UpdateOptions{})
+	assert.NoError(t, err)
+	watcher, _ := watches.next(t)
+	watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+
+	resp := receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_MODIFIED, resp.Type)
+	assert.Equal(t, "Running", resp.Status)
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "5", resourceVersion)
+
+	// the rocket is gone while the resourceVersion expired
+	err = chatclient.Rockets(TestNamespace).Delete(context.TODO(), "foo", metav1.DeleteOptions{})
+	assert.NoError(t, err)
+	watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+	resp = receiveStatus(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_DELETED, resp.Type)
+	assert.NoError(t, <-errs)
+}
+
+func TestRocket_Status_cancel(t *testing.T) {
+	chatclient := testutils.NewFakeChatClient(*statusRocket("1", "Creating"))
+	watches := newFakeWatches(chatclient)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := testutils.NewFakeStatusStream(ctx)
+	errs := runStatus(s, stream)
+	receiveStatus(t, stream)
+	watcher, _ := watches.next(t)
+
+	cancel()
+	select {
+	case err := <-errs:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Status didn't return after the stream was canceled")
+	}
+	assert.True(t, watcher.IsStopped())
+}
+
+func receiveRocketEvent(t *testing.T, stream *testutils.FakeWatchRocketsStream) *rocketpb.WatchRocketsResponse {
+	select {
+	case resp := <-stream.Responses:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for rocket event")
+		return nil
+	}
+}
+
+func TestRocket_WatchRockets(t *testing.T) {
+	chatclient := testutils.NewFakeChatClient(*statusRocket("1", "Running"))
+	watches := newFakeWatches(chatclient)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := testutils.NewFakeWatchRocketsStream(ctx)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.WatchRockets(&rocketpb.WatchRocketsRequest{Namespace: TestNamespace}, stream)
+	}()
+
+	// the existing rockets are listed first
+	resp := receiveRocketEvent(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_ADDED, resp.Type)
+	assert.Equal(t, "foo", resp.Rocket.Name)
+	resp = receiveRocketEvent(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_BOOKMARK, resp.Type)
+	assert.Nil(t, resp.Rocket)
+
+	watcher, _ := watches.next(t)
+	watcher.Add(&chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: TestNamespace, ResourceVersion: "2"}})
+	resp = receiveRocketEvent(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_ADDED, resp.Type)
+	assert.Equal(t, "bar", resp.Rocket.Name)
+	assert.Equal(t, "2", resp.ResourceVersion)
+
+	watcher.Action(watch.Bookmark, &chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "3"}})
+	resp = receiveRocketEvent(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_BOOKMARK, resp.Type)
+	assert.Equal(t, "3", resp.ResourceVersion)
+
+	watcher.Delete(statusRocket("4", "Running"))
+	resp = receiveRocketEvent(t, stream)
+	assert.Equal(t, rocketpb.EventType_EVENT_TYPE_DELETED, resp.Type)
+	assert.Equal(t, "foo", resp.Rocket.Name)
+
+	cancel()
+	assert.NoError(t, <-errs)
+}
+
+func TestRocket_WatchRockets_resume(t *testing.T) {
+	chatclient := testutils.NewFakeChatClient(*statusRocket("1", "Running"))
+	watches := newFakeWatches(chatclient)
+	s := NewRocketServiceImpl(testutils.NewFakeClientFactory(fake.NewSimpleClientset(), chatclient))
+	stream := testutils.NewFakeWatchRocketsStream(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		errs <- s.WatchRockets(&rocketpb.WatchRocketsRequest{Namespace: TestNamespace, ResourceVersion: "3"}, stream)
+	}()
+
+	// resumed watches don't list the rockets again
+	watcher, resourceVersion := watches.next(t)
+	assert.Equal(t, "3", resourceVersion)
+	assert.Empty(t, stream.Responses)
+
+	watcher.Error(&metav1.Status{Status: metav1.StatusFailure, Code: http.StatusGone, Reason: metav1.StatusReasonExpired})
+	err := <-errs
+	assert.True(t, isExpired(err))
+}
@@ -0,0 +1,470 @@
+package tenant
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	chatv1alpha1Client "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
+	"github.com/grpc-ecosystem/go-grpc-middleware/logging/zap/ctxzap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
+)
+
+const (
+	// TenantLabel marks the namespaces provisioned for tenants
+	TenantLabel = "chat.accso.de/tenant"
+	// SubjectAnnotation is the subject of the token of the user a tenant namespace belongs to
+	SubjectAnnotation = "chat.accso.de/subject"
+	// DefaultClusterRole is the cluster role bound to users in their tenant namespace
+	DefaultClusterRole = "chat-tenant"
+
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedBy      = "chat-api-server"
+
+	namespacePrefix = "tenant-"
+	quotaName       = "tenant-quota"
+	limitRangeName  = "tenant-limits"
+	roleBindingName = "tenant-admin"
+)
+
+// defaultLimits are the limits and requests set on containers without resources, capped by the quota of the tenant
+var defaultLimits = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("500m"),
+	corev1.ResourceMemory: resource.MustParse("512Mi"),
+}
+var defaultRequests = corev1.ResourceList{
+	corev1.ResourceCPU:    resource.MustParse("100m"),
+	corev1.ResourceMemory: resource.MustParse("256Mi"),
+}
+
+// Tenant provisions the namespaces of users with the credentials of the api-server,
+// because users can't create namespaces themselves
+type Tenant struct {
+	kubeclient kubernetes.Interface
+	chatclient chatv1alpha1Client.ChatV1alpha1Interface
+	// authorizer allows users to list the tenants of all users
+	authorizer k8sutil.Authorizer
+
+	clusterRole    string
+	usernameClaim  string
+	usernamePrefix string
+	// maxQuota caps the resources users can register for their tenant
+	maxQuota corev1.ResourceList
+}
+
+// Option configures optional settings of the Tenant service
+type Option func(*Tenant)
+
+// WithClusterRole sets the cluster role bound to users in their namespace, it needs to grant the rights on rockets
+func WithClusterRole(name string) Option {
+	return func(t *Tenant) {
+		t.clusterRole = name
+	}
+}
+
+// WithUsername sets the claim and prefix of the kubernetes username of users,
+// they should match the --oidc-username-claim and --oidc-username-prefix of the cluster
+func WithUsername(claim, prefix string) Option {
+	return func(t *Tenant) {
+		t.usernameClaim = claim
+		t.usernamePrefix = prefix
+	}
+}
+
+// WithMaxQuota rejects registrations with more cpu, memory or storage than max
+func WithMaxQuota(cpu, memory, storage resource.Quantity) Option {
+	return func(t *Tenant) {
+		t.maxQuota = corev1.ResourceList{
+			corev1.ResourceCPU:     cpu,
+			corev1.ResourceMemory:  memory,
+			corev1.ResourceStorage: storage,
+		}
+	}
+}
+
+// NewTenantServiceImpl returns a Tenant service that provisions tenants with the clients of the api-server
+func NewTenantServiceImpl(kubeclient kubernetes.Interface, chatclient chatv1alpha1Client.ChatV1alpha1Interface, authorizer k8sutil.Authorizer, opts ...Option) *Tenant {
+	t := &Tenant{
+		kubeclient:    kubeclient,
+		chatclient:    chatclient,
+		authorizer:    authorizer,
+		clusterRole:   DefaultClusterRole,
+		usernameClaim: "sub",
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// NamespaceName returns the name of the namespace of the tenant of subject.
+// Subjects that aren't valid DNS-1123 labels are sanitized and suffixed with a hash of the subject,
+// to keep the names of different subjects apart.
+func NamespaceName(subject string) string {
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(subject), "-"), "-")
+	if name == subject && len(namespacePrefix+name) <= 63 {
+		return namespacePrefix + name
+	}
+	hash := sha256.Sum256([]byte(subject))
+	suffix := "-" + hex.EncodeToString(hash[:4])
+	if max := 63 - len(namespacePrefix) - len(suffix); len(name) > max {
+		name = strings.TrimRight(name[:max], "-")
+	}
+	return namespacePrefix + name + suffix
+}
+
+// Register provisions the tenant of the user: a namespace with a quota of the resources, default limits of containers
+// and a role binding that grants the user the rights on rockets. Registering again updates the tenant to the request.
+func (t *Tenant) Register(ctx context.Context, cpu, memory, storage resource.Quantity) (*service.Tenant, error) {
+	l := ctxzap.Extract(ctx)
+	claims, err := oauth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	username, err := claims.Username(t.usernameClaim)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	hard := corev1.ResourceList{
+		corev1.ResourceLimitsCPU:       cpu,
+		corev1.ResourceLimitsMemory:    memory,
+		corev1.ResourceRequestsCPU:     cpu,
+		corev1.ResourceRequestsMemory:  memory,
+		corev1.ResourceRequestsStorage: storage,
+	}
+	if err := t.checkQuota(cpu, memory, storage); err != nil {
+		return nil, err
+	}
+
+	namespace, err := t.ensureNamespace(ctx, claims.Subject)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	quota, err := t.ensureQuota(ctx, namespace.Name, hard)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	if err := t.ensureLimitRange(ctx, namespace.Name, hard); err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	if err := t.ensureRoleBinding(ctx, namespace.Name, t.usernamePrefix+username); err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	l.Debug(fmt.Sprintf("Registered tenant %v for user %v", namespace.Name, username))
+	return &service.Tenant{Namespace: *namespace, Quota: quota}, nil
+}
+
+// checkQuota rejects quotas above the maximum quota
+func (t *Tenant) checkQuota(cpu, memory, storage resource.Quantity) error {
+	requested := corev1.ResourceList{
+		corev1.ResourceCPU:     cpu,
+		corev1.ResourceMemory:  memory,
+		corev1.ResourceStorage: storage,
+	}
+	for name, quantity := range requested {
+		if quantity.Sign() <= 0 {
+			return status.Errorf(codes.InvalidArgument, "Quota of %v needs to be positive", name)
+		}
+		if max, ok := t.maxQuota[name]; ok && quantity.Cmp(max) > 0 {
+			return status.Errorf(codes.InvalidArgument, "Quota of %v can't exceed %v", name, max.String())
+		}
+	}
+	return nil
+}
+
+// Get returns the tenant of the user
+func (t *Tenant) Get(ctx context.Context) (*service.Tenant, error) {
+	l := ctxzap.Extract(ctx)
+	claims, err := oauth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := t.get(ctx, claims.Subject)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	return tenant, nil
+}
+
+func (t *Tenant) get(ctx context.Context, subject string) (*service.Tenant, error) {
+	name := NamespaceName(subject)
+	namespace, err := t.kubeclient.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) || (err == nil && namespace.Annotations[SubjectAnnotation] != subject) {
+		return nil, status.Error(codes.NotFound, "User is not registered")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error getting namespace of tenant: %w", err)
+	}
+	tenant := &service.Tenant{Namespace: *namespace}
+	quota, err := t.kubeclient.CoreV1().ResourceQuotas(name).Get(ctx, quotaName, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		tenant.Quota = quota
+	case !apiErrors.IsNotFound(err):
+		return nil, fmt.Errorf("Error getting quota of tenant: %w", err)
+	}
+	return tenant, nil
+}
+
+// List returns the tenants of all users sorted by namespace, if the user is allowed to list namespaces
+func (t *Tenant) List(ctx context.Context) ([]service.Tenant, error) {
+	l := ctxzap.Extract(ctx)
+	err := t.authorizer.Authorize(ctx, authorizationv1.ResourceAttributes{Verb: "list", Resource: "namespaces"})
+	if err != nil {
+		return nil, err
+	}
+	namespaces, err := t.kubeclient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: TenantLabel})
+	if err != nil {
+		err = fmt.Errorf("Error getting namespaces of tenants: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	quotas, err := t.kubeclient.CoreV1().ResourceQuotas("").List(ctx, metav1.ListOptions{LabelSelector: managedByLabel + "=" + managedBy})
+	if err != nil {
+		err = fmt.Errorf("Error getting quotas of tenants: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	quotaOf := make(map[string]*corev1.ResourceQuota)
+	for i := range quotas.Items {
+		if quotas.Items[i].Name == quotaName {
+			quotaOf[quotas.Items[i].Namespace] = &quotas.Items[i]
+		}
+	}
+
+	tenants := make([]service.Tenant, 0, len(namespaces.Items))
+	for _, namespace := range namespaces.Items {
+		tenants = append(tenants, service.Tenant{Namespace: namespace, Quota: quotaOf[namespace.Name]})
+	}
+	sort.Slice(tenants, func(i, j int) bool {
+		return tenants[i].Namespace.Name < tenants[j].Namespace.Name
+	})
+	return tenants, nil
+}
+
+// Deregister deletes the namespace of the tenant of the user. Unless forced, tenants with rockets aren't deleted.
+func (t *Tenant) Deregister(ctx context.Context, force bool) (*service.Tenant, error) {
+	l := ctxzap.Extract(ctx)
+	claims, err := oauth.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := t.get(ctx, claims.Subject)
+	if err != nil {
+		l.Error(err.Error())
+		return nil, err
+	}
+	name := tenant.Namespace.Name
+	if !force {
+		rockets, err := t.chatclient.Rockets(name).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			err = fmt.Errorf("Error getting rockets of tenant: %w", err)
+			l.Error(err.Error())
+			return nil, err
+		}
+		if len(rockets.Items) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "Tenant has %v rockets, which are deleted with the tenant only if forced", len(rockets.Items))
+		}
+	}
+	err = t.kubeclient.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !apiErrors.IsNotFound(err) {
+		err = fmt.Errorf("Error deleting namespace of tenant: %w", err)
+		l.Error(err.Error())
+		return nil, err
+	}
+	tenant.Namespace.Status.Phase = corev1.NamespaceTerminating
+	return tenant, nil
+}
+
+// objectMeta returns the metadata of the objects provisioned for tenants
+func objectMeta(name, namespace string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:      name,
+		Namespace: namespace,
+		Labels:    map[string]string{managedByLabel: managedBy},
+	}
+}
+
+func (t *Tenant) ensureNamespace(ctx context.Context, subject string) (*corev1.Namespace, error) {
+	namespaces := t.kubeclient.CoreV1().Namespaces()
+	desired := &corev1.Namespace{ObjectMeta: objectMeta(NamespaceName(subject), "")}
+	desired.Labels[TenantLabel] = "true"
+	desired.Annotations = map[string]string{SubjectAnnotation: subject}
+
+	namespace, err := namespaces.Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return namespace, nil
+	}
+	if !apiErrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("Error creating namespace of tenant: %w", err)
+	}
+	namespace, err = namespaces.Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting namespace of tenant: %w", err)
+	}
+	if namespace.Annotations[SubjectAnnotation] != subject {
+		return nil, status.Errorf(codes.AlreadyExists, "Namespace %v doesn't belong to the user", namespace.Name)
+	}
+	if namespace.DeletionTimestamp != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Tenant is being deregistered, register again once it is deleted")
+	}
+	if namespace.Labels[TenantLabel] == "true" {
+		return namespace, nil
+	}
+	if namespace.Labels == nil {
+		namespace.Labels = make(map[string]string)
+	}
+	namespace.Labels[TenantLabel] = "true"
+	namespace, err = namespaces.Update(ctx, namespace, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error updating namespace of tenant: %w", err)
+	}
+	return namespace, nil
+}
+
+func (t *Tenant) ensureQuota(ctx context.Context, namespace string, hard corev1.ResourceList) (*corev1.ResourceQuota, error) {
+	quotas := t.kubeclient.CoreV1().ResourceQuotas(namespace)
+	desired := &corev1.ResourceQuota{
+		ObjectMeta: objectMeta(quotaName, namespace),
+		Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+	}
+	quota, err := quotas.Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return quota, nil
+	}
+	if !apiErrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("Error creating quota of tenant: %w", err)
+	}
+	quota, err = quotas.Get(ctx, quotaName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting quota of tenant: %w", err)
+	}
+	if equality.Semantic.DeepEqual(quota.Spec, desired.Spec) {
+		return quota, nil
+	}
+	quota.Spec = desired.Spec
+	quota, err = quotas.Update(ctx, quota, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error updating quota of tenant: %w", err)
+	}
+	return quota, nil
+}
+
+// ensureLimitRange sets default resources of containers, pods without resources are rejected by the quota otherwise
+func (t *Tenant) ensureLimitRange(ctx context.Context, namespace string, hard corev1.ResourceList) error {
+	limitRanges := t.kubeclient.CoreV1().LimitRanges(namespace)
+	desired := &corev1.LimitRange{
+		ObjectMeta: objectMeta(limitRangeName, namespace),
+		Spec: corev1.LimitRangeSpec{
+			Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				Default:        capResources(defaultLimits, hard),
+				DefaultRequest: capResources(defaultRequests, hard),
+			}},
+		},
+	}
+	_, err := limitRanges.Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("Error creating limit range of tenant: %w", err)
+	}
+	limitRange, err := limitRanges.Get(ctx, limitRangeName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting limit range of tenant: %w", err)
+	}
+	if equality.Semantic.DeepEqual(limitRange.Spec, desired.Spec) {
+		return nil
+	}
+	limitRange.Spec = desired.Spec
+	_, err = limitRanges.Update(ctx, limitRange, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Error updating limit range of tenant: %w", err)
+	}
+	return nil
+}
+
+// capResources returns the resources capped by the limits of the quota
+func capResources(resources, hard corev1.ResourceList) corev1.ResourceList {
+	capped := resources.DeepCopy()
+	for name, quantity := range capped {
+		if max, ok := hard[corev1.ResourceName("limits."+name)]; ok && quantity.Cmp(max) > 0 {
+			capped[name] = max.DeepCopy()
+		}
+	}
+	return capped
+}
+
+func (t *Tenant) ensureRoleBinding(ctx context.Context, namespace, username string) error {
+	roleBindings := t.kubeclient.RbacV1().RoleBindings(namespace)
+	desired := &rbacv1.RoleBinding{
+		ObjectMeta: objectMeta(roleBindingName, namespace),
+		Subjects: []rbacv1.Subject{{
+			Kind:     rbacv1.UserKind,
+			APIGroup: rbacv1.GroupName,
+			Name:     username,
+		}},
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			APIGroup: rbacv1.GroupName,
+			Name:     t.clusterRole,
+		},
+	}
+	_, err := roleBindings.Create(ctx, desired, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apiErrors.IsAlreadyExists(err) {
+		return fmt.Errorf("Error creating role binding of tenant: %w", err)
+	}
+	roleBinding, err := roleBindings.Get(ctx, roleBindingName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting role binding of tenant: %w", err)
+	}
+	if equality.Semantic.DeepEqual(roleBinding.RoleRef, desired.RoleRef) {
+		if equality.Semantic.DeepEqual(roleBinding.Subjects, desired.Subjects) {
+			return nil
+		}
+		roleBinding.Subjects = desired.Subjects
+		_, err = roleBindings.Update(ctx, roleBinding, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("Error updating role binding of tenant: %w", err)
+		}
+		return nil
+	}
+	// the role of a binding can't be changed
+	err = roleBindings.Delete(ctx, roleBindingName, metav1.DeleteOptions{})
+	if err != nil {
+		return fmt.Errorf("Error deleting role binding of tenant: %w", err)
+	}
+	_, err = roleBindings.Create(ctx, desired, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("Error creating role binding of tenant: %w", err)
+	}
+	return nil
+}
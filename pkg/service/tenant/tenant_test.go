@@ -0,0 +1,318 @@
+package tenant
+
+import (
+	"context"
+	"testing"
+
+	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/grpc/apierror"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/oauth"
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/testutils"
+)
+
+const testSubject = "f5b2c1e4-7d1a-4c59-9b0e-2a6f3d8e9c10"
+
+// fakeAuthorizer allows every user if allowed is set
+type fakeAuthorizer struct {
+	allowed bool
+}
+
+func (a *fakeAuthorizer) Authorize(ctx context.Context, attributes authorizationv1.ResourceAttributes) error {
+	if !a.allowed {
+		return status.Errorf(codes.PermissionDenied, "User can't %v %v", attributes.Verb, attributes.Resource)
+	}
+	return nil
+}
+
+func userContext(subject string) context.Context {
+	return context.WithValue(context.Background(), oauth.ClaimsKey, &oauth.Claims{Subject: subject, PreferredUsername: "alice"})
+}
+
+func tenantNamespace(subject string) *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        NamespaceName(subject),
+			Labels:      map[string]string{TenantLabel: "true", managedByLabel: managedBy},
+			Annotations: map[string]string{SubjectAnnotation: subject},
+		},
+		Status: corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+}
+
+func TestNamespaceName(t *testing.T) {
+	tests := []struct {
+		name    string
+		subject string
+		want    string
+	}{
+		{
+			name:    "uuid",
+			subject: testSubject,
+			want:    "tenant-" + testSubject,
+		},
+		{
+			name:    "email",
+			subject: "Alice@example.com",
+			want:    "tenant-alice-example-com-",
+		},
+		{
+			name:    "long subject",
+			subject: "a-very-long-subject-of-a-token-that-does-not-fit-into-a-namespace-name",
+			want:    "tenant-a-very-long-subject-of-a-token-that-does-not-fi-",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NamespaceName(tt.subject)
+			assert.Empty(t, validation.IsDNS1123Label(got))
+			assert.Contains(t, got, tt.want)
+		})
+	}
+	// sanitized subjects stay apart
+	assert.NotEqual(t, NamespaceName("alice@example.com"), NamespaceName("alice.example.com"))
+}
+
+func TestTenant_Register(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		objs     []*corev1.Namespace
+		cpu      string
+		wantCode codes.Code
+	}{
+		{
+			name:    "new tenant",
+			subject: testSubject,
+			cpu:     "2",
+		},
+		{
+			name:    "registered tenant",
+			subject: testSubject,
+			objs:    []*corev1.Namespace{tenantNamespace(testSubject)},
+			cpu:     "2",
+		},
+		{
+			name:    "namespace of another user",
+			subject: testSubject,
+			objs: []*corev1.Namespace{{
+				ObjectMeta: metav1.ObjectMeta{Name: NamespaceName(testSubject)},
+			}},
+			cpu:      "2",
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:    "deregistering tenant",
+			subject: testSubject,
+			objs: []*corev1.Namespace{func() *corev1.Namespace {
+				ns := tenantNamespace(testSubject)
+				ns.DeletionTimestamp = &metav1.Time{}
+				return ns
+			}()},
+			cpu:      "2",
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:     "quota too large",
+			subject:  testSubject,
+			cpu:      "16",
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeclient := fake.NewSimpleClientset()
+			for _, ns := range tt.objs {
+				_, err := kubeclient.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			s := NewTenantServiceImpl(kubeclient, testutils.NewFakeChatClient(), &fakeAuthorizer{},
+				WithUsername("preferred_username", "oidc:"),
+				WithMaxQuota(resource.MustParse("4"), resource.MustParse("8Gi"), resource.MustParse("50Gi")),
+			)
+			tenant, err := s.Register(userContext(tt.subject), resource.MustParse(tt.cpu), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			assert.NoError(t, err)
+			name := NamespaceName(tt.subject)
+			assert.Equal(t, name, tenant.Namespace.Name)
+			assert.Equal(t, tt.subject, tenant.Namespace.Annotations[SubjectAnnotation])
+			assert.Equal(t, "true", tenant.Namespace.Labels[TenantLabel])
+
+			hard := tenant.Quota.Spec.Hard
+			assert.Equal(t, "2", hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String())
+			assert.Equal(t, "4Gi", hard.Name(corev1.ResourceRequestsMemory, resource.BinarySI).String())
+			assert.Equal(t, "20Gi", hard.Name(corev1.ResourceRequestsStorage, resource.BinarySI).String())
+
+			limitRange, err := kubeclient.CoreV1().LimitRanges(name).Get(context.TODO(), limitRangeName, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, defaultLimits, limitRange.Spec.Limits[0].Default)
+
+			roleBinding, err := kubeclient.RbacV1().RoleBindings(name).Get(context.TODO(), roleBindingName, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, []rbacv1.Subject{{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: "oidc:alice"}}, roleBinding.Subjects)
+			assert.Equal(t, DefaultClusterRole, roleBinding.RoleRef.Name)
+		})
+	}
+}
+
+func TestTenant_Register_idempotent(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset()
+	s := NewTenantServiceImpl(kubeclient, testutils.NewFakeChatClient(), &fakeAuthorizer{})
+	ctx := userContext(testSubject)
+	_, err := s.Register(ctx, resource.MustParse("2"), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+	assert.NoError(t, err)
+
+	// registering again with the same resources changes nothing
+	kubeclient.ClearActions()
+	_, err = s.Register(ctx, resource.MustParse("2"), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+	assert.NoError(t, err)
+	for _, action := range kubeclient.Actions() {
+		assert.Contains(t, []string{"create", "get"}, action.GetVerb())
+	}
+
+	// registering with other resources updates the quota and caps the default limits
+	tenant, err := s.Register(ctx, resource.MustParse("250m"), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+	assert.NoError(t, err)
+	assert.Equal(t, "250m", tenant.Quota.Spec.Hard.Name(corev1.ResourceLimitsCPU, resource.DecimalSI).String())
+	limitRange, err := kubeclient.CoreV1().LimitRanges(NamespaceName(testSubject)).Get(context.TODO(), limitRangeName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "250m", limitRange.Spec.Limits[0].Default.Cpu().String())
+	assert.Equal(t, "100m", limitRange.Spec.Limits[0].DefaultRequest.Cpu().String())
+
+	// a changed cluster role replaces the binding
+	s.clusterRole = "chat-tenant-v2"
+	_, err = s.Register(ctx, resource.MustParse("250m"), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+	assert.NoError(t, err)
+	roleBinding, err := kubeclient.RbacV1().RoleBindings(NamespaceName(testSubject)).Get(context.TODO(), roleBindingName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "chat-tenant-v2", roleBinding.RoleRef.Name)
+
+	quotas, err := kubeclient.CoreV1().ResourceQuotas(NamespaceName(testSubject)).List(context.TODO(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, quotas.Items, 1)
+}
+
+func TestTenant_Get(t *testing.T) {
+	kubeclient := fake.NewSimpleClientset(tenantNamespace(testSubject))
+	s := NewTenantServiceImpl(kubeclient, testutils.NewFakeChatClient(), &fakeAuthorizer{})
+
+	tenant, err := s.Get(userContext(testSubject))
+	assert.NoError(t, err)
+	assert.Equal(t, NamespaceName(testSubject), tenant.Namespace.Name)
+	assert.Nil(t, tenant.Quota)
+
+	_, err = s.Get(userContext("another-user"))
+	assert.Equal(t, codes.NotFound, status.Code(apierror.ToStatus(err)))
+
+	_, err = s.Get(context.Background())
+	assert.Equal(t, codes.Unauthenticated, status.Code(apierror.ToStatus(err)))
+}
+
+func TestTenant_List(t *testing.T) {
+	tests := []struct {
+		name     string
+		allowed  bool
+		want     []string
+		wantCode codes.Code
+	}{
+		{
+			name:    "admin",
+			allowed: true,
+			want:    []string{NamespaceName("another-user"), NamespaceName(testSubject)},
+		},
+		{
+			name:     "user",
+			wantCode: codes.PermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeclient := fake.NewSimpleClientset(
+				tenantNamespace(testSubject),
+				tenantNamespace("another-user"),
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+			)
+			s := NewTenantServiceImpl(kubeclient, testutils.NewFakeChatClient(), &fakeAuthorizer{allowed: tt.allowed})
+			_, err := s.Register(userContext(testSubject), resource.MustParse("2"), resource.MustParse("4Gi"), resource.MustParse("20Gi"))
+			assert.NoError(t, err)
+
+			tenants, err := s.List(userContext(testSubject))
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				return
+			}
+			assert.NoError(t, err)
+			var names []string
+			for _, tenant := range tenants {
+				names = append(names, tenant.Namespace.Name)
+			}
+			assert.Equal(t, tt.want, names)
+			// only the registered tenant has a quota
+			assert.Nil(t, tenants[0].Quota)
+			assert.NotNil(t, tenants[1].Quota)
+		})
+	}
+}
+
+func TestTenant_Deregister(t *testing.T) {
+	rocket := chatv1alpha1.Rocket{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: NamespaceName(testSubject)}}
+	tests := []struct {
+		name     string
+		subject  string
+		rockets  []chatv1alpha1.Rocket
+		force    bool
+		wantCode codes.Code
+	}{
+		{
+			name:    "tenant without rockets",
+			subject: testSubject,
+		},
+		{
+			name:     "tenant with rockets",
+			subject:  testSubject,
+			rockets:  []chatv1alpha1.Rocket{rocket},
+			wantCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "forced",
+			subject: testSubject,
+			rockets: []chatv1alpha1.Rocket{rocket},
+			force:   true,
+		},
+		{
+			name:     "not registered",
+			subject:  "another-user",
+			wantCode: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kubeclient := fake.NewSimpleClientset(tenantNamespace(testSubject))
+			s := NewTenantServiceImpl(kubeclient, testutils.NewFakeChatClient(tt.rockets...), &fakeAuthorizer{})
+			tenant, err := s.Deregister(userContext(tt.subject), tt.force)
+			if tt.wantCode != codes.OK {
+				assert.Equal(t, tt.wantCode, status.Code(apierror.ToStatus(err)))
+				_, err = kubeclient.CoreV1().Namespaces().Get(context.TODO(), NamespaceName(testSubject), metav1.GetOptions{})
+				assert.NoError(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, corev1.NamespaceTerminating, tenant.Namespace.Status.Phase)
+			_, err = kubeclient.CoreV1().Namespaces().Get(context.TODO(), NamespaceName(testSubject), metav1.GetOptions{})
+			assert.Error(t, err)
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package testutils
 
 import (
+	"context"
+
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/k8sutil"
 	chatv1alpha1 "github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
 	fakeChat "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/fake"
 	chatv1alpha1Client "github.com/bachelor-thesis-hown3d/chat-operator/pkg/client/clientset/versioned/typed/chat.accso.de/v1alpha1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 
+	certmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned"
 	fakeCertmanager "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/fake"
 	certmanagerv1Client "github.com/jetstack/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
 )
@@ -29,3 +34,36 @@ func NewFakeChatClient(objs ...chatv1alpha1.Rocket) chatv1alpha1Client.ChatV1alp
 func NewFakeCertManagerClient(objs ...runtime.Object) certmanagerv1Client.CertmanagerV1Interface {
 	return fakeCertmanager.NewSimpleClientset(objs...).CertmanagerV1()
 }
+
+type fakeClientFactory struct {
+	kubeclient        kubernetes.Interface
+	chatclient        chatv1alpha1Client.ChatV1alpha1Interface
+	certmanagerclient certmanager.Interface
+}
+
+// NewFakeClientFactory returns a ClientFactory that hands out the provided clients for every request
+// and a cert-manager client without any objects
+func NewFakeClientFactory(kubeclient kubernetes.Interface, chatclient chatv1alpha1Client.ChatV1alpha1Interface) k8sutil.ClientFactory {
+	return NewFakeClientFactoryWithCertManager(kubeclient, chatclient, fakeCertmanager.NewSimpleClientset())
+}
+
+// NewFakeClientFactoryWithCertManager returns a ClientFactory that hands out the provided clients for every request
+func NewFakeClientFactoryWithCertManager(kubeclient kubernetes.Interface, chatclient chatv1alpha1Client.ChatV1alpha1Interface, certmanagerclient certmanager.Interface) k8sutil.ClientFactory {
+	return fakeClientFactory{
+		kubeclient:        kubeclient,
+		chatclient:        chatclient,
+		certmanagerclient: certmanagerclient,
+	}
+}
+
+func (f fakeClientFactory) KubeClient(_ context.Context) (kubernetes.Interface, error) {
+	return f.kubeclient, nil
+}
+
+func (f fakeClientFactory) ChatClient(_ context.Context) (chatv1alpha1Client.ChatV1alpha1Interface, error) {
+	return f.chatclient, nil
+}
+
+func (f fakeClientFactory) CertManagerClient(_ context.Context) (certmanager.Interface, error) {
+	return f.certmanagerclient, nil
+}
@@ -3,9 +3,14 @@ package testutils
 import (
 	"context"
 
+	"github.com/bachelor-thesis-hown3d/chat-api-server/pkg/service"
 	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
 	"github.com/bachelor-thesis-hown3d/chat-operator/api/chat.accso.de/v1alpha1"
+	cmapi "github.com/jetstack/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
 /*
@@ -34,40 +39,148 @@ func (m *MockedRocket) Get(ctx context.Context, name, namespace string) (*v1alph
 	return args.Get(0).(*v1alpha1.Rocket), args.Error(1)
 
 }
-func (m *MockedRocket) AvailableVersions(repo string) ([]string, error) {
+func (m *MockedRocket) AvailableVersions(ctx context.Context, repo string) ([]string, error) {
 	args := m.Called(repo)
 	return args.Get(0).([]string), args.Error(1)
 }
-func (m *MockedRocket) Delete(ctx context.Context, name, namespace string) error {
-	args := m.Called(name, namespace)
-	return args.Error(0)
+func (m *MockedRocket) Delete(ctx context.Context, name, namespace string, dryRun bool) (*v1alpha1.Rocket, []string, error) {
+	args := m.Called(name, namespace, dryRun)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*v1alpha1.Rocket), args.Get(1).([]string), args.Error(2)
 }
 func (m *MockedRocket) Status(name, namespace string, stream rocketpb.RocketService_StatusServer) error {
 	args := m.Called(name, namespace, stream)
 	return args.Error(0)
 }
-
-func (m *MockedRocket) Create(ctx context.Context, host, name, namespace, user, email string, databaseSize int64, replicas int32) error {
-	args := m.Called(ctx, host, name, namespace, user, email, databaseSize)
+func (m *MockedRocket) WatchRockets(req *rocketpb.WatchRocketsRequest, stream rocketpb.RocketService_WatchRocketsServer) error {
+	args := m.Called(req, stream)
 	return args.Error(0)
+}
+
+func (m *MockedRocket) Create(ctx context.Context, host, name, namespace, email, user, rocketVersion, mongodbVersion, ingressClass string, databaseSize int64, replicas int32, dryRun bool) (*v1alpha1.Rocket, error) {
+	args := m.Called(ctx, host, name, namespace, email, user, rocketVersion, mongodbVersion, ingressClass, databaseSize, dryRun)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*v1alpha1.Rocket), args.Error(1)
 
 }
-func (m *MockedRocket) Logs(name, namespace, pod string, stream rocketpb.RocketService_LogsServer) error {
-	args := m.Called(name, namespace, pod, stream)
+func (m *MockedRocket) Update(ctx context.Context, req *rocketpb.UpdateRequest) (*v1alpha1.Rocket, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*v1alpha1.Rocket), args.Error(1)
+}
+
+func (m *MockedRocket) Logs(req *rocketpb.LogsRequest, stream rocketpb.RocketService_LogsServer) error {
+	args := m.Called(req, stream)
 	return args.Error(0)
 }
 
-func (m *MockedRocket) GetAll(ctx context.Context, namespace string) (*v1alpha1.RocketList, error) {
+func (m *MockedRocket) LogsArchive(ctx context.Context, req *rocketpb.LogsArchiveRequest) ([]byte, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (m *MockedRocket) Events(ctx context.Context, name, namespace string) ([]corev1.Event, error) {
+	args := m.Called(ctx, name, namespace)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]corev1.Event), args.Error(1)
+}
+
+func (m *MockedRocket) GetIssuer(ctx context.Context, namespace, user string) (*cmapi.Issuer, error) {
+	args := m.Called(ctx, namespace, user)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cmapi.Issuer), args.Error(1)
+}
+
+func (m *MockedRocket) UpdateIssuer(ctx context.Context, req *rocketpb.UpdateIssuerRequest) (*cmapi.Issuer, error) {
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*cmapi.Issuer), args.Error(1)
+}
+
+func (m *MockedRocket) Certificate(ctx context.Context, name, namespace string) (*service.CertificateStatus, error) {
+	args := m.Called(ctx, name, namespace)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.CertificateStatus), args.Error(1)
+}
 
-	args := m.Called(ctx, namespace)
+func (m *MockedRocket) IngressClasses(ctx context.Context) ([]networkingv1.IngressClass, error) {
+	args := m.Called(ctx)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
-	return args.Get(0).(*v1alpha1.RocketList), args.Error(1)
+	return args.Get(0).([]networkingv1.IngressClass), args.Error(1)
+}
 
+func (m *MockedRocket) CheckHostAvailability(ctx context.Context, host, name, namespace string) error {
+	args := m.Called(ctx, host, name, namespace)
+	return args.Error(0)
 }
 
-func (m *MockedRocket) Register(ctx context.Context, user string, cpu, mem int64) error {
-	args := m.Called(ctx, user, cpu, mem)
+func (m *MockedRocket) WatchEvents(req *rocketpb.WatchEventsRequest, stream rocketpb.RocketService_WatchEventsServer) error {
+	args := m.Called(req, stream)
 	return args.Error(0)
 }
+
+func (m *MockedRocket) GetAll(ctx context.Context, req *rocketpb.GetAllRequest) (*service.RocketPage, error) {
+
+	args := m.Called(ctx, req)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.RocketPage), args.Error(1)
+
+}
+
+// MockedTenant is a mocked TenantService that returns what the mock is told to
+type MockedTenant struct {
+	mock.Mock
+}
+
+func (m *MockedTenant) Register(ctx context.Context, cpu, memory, storage resource.Quantity) (*service.Tenant, error) {
+	args := m.Called(ctx, cpu, memory, storage)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.Tenant), args.Error(1)
+}
+
+func (m *MockedTenant) Get(ctx context.Context) (*service.Tenant, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.Tenant), args.Error(1)
+}
+
+func (m *MockedTenant) List(ctx context.Context) ([]service.Tenant, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]service.Tenant), args.Error(1)
+}
+
+func (m *MockedTenant) Deregister(ctx context.Context, force bool) (*service.Tenant, error) {
+	args := m.Called(ctx, force)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*service.Tenant), args.Error(1)
+}
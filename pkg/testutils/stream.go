@@ -0,0 +1,115 @@
+package testutils
+
+import (
+	"context"
+
+	rocketpb "github.com/bachelor-thesis-hown3d/chat-api-server/proto/rocket/v1"
+	"google.golang.org/grpc/metadata"
+)
+
+// FakeServerStream is a grpc.ServerStream without a connection, used to call streaming services directly
+type FakeServerStream struct {
+	Ctx context.Context
+}
+
+func (s *FakeServerStream) Context() context.Context {
+	return s.Ctx
+}
+
+func (s *FakeServerStream) SetHeader(metadata.MD) error  { return nil }
+func (s *FakeServerStream) SendHeader(metadata.MD) error { return nil }
+func (s *FakeServerStream) SetTrailer(metadata.MD)       {}
+func (s *FakeServerStream) SendMsg(interface{}) error    { return nil }
+func (s *FakeServerStream) RecvMsg(interface{}) error    { return nil }
+
+// FakeStatusStream records the responses sent by the Status service
+type FakeStatusStream struct {
+	FakeServerStream
+	Responses chan *rocketpb.StatusResponse
+}
+
+// NewFakeStatusStream returns a stream whose responses can be received from its Responses channel
+func NewFakeStatusStream(ctx context.Context) *FakeStatusStream {
+	return &FakeStatusStream{
+		FakeServerStream: FakeServerStream{Ctx: ctx},
+		Responses:        make(chan *rocketpb.StatusResponse, 16),
+	}
+}
+
+func (s *FakeStatusStream) Send(resp *rocketpb.StatusResponse) error {
+	select {
+	case s.Responses <- resp:
+		return nil
+	case <-s.Ctx.Done():
+		return s.Ctx.Err()
+	}
+}
+
+// FakeWatchRocketsStream records the responses sent by the WatchRockets service
+type FakeWatchRocketsStream struct {
+	FakeServerStream
+	Responses chan *rocketpb.WatchRocketsResponse
+}
+
+// NewFakeWatchRocketsStream returns a stream whose responses can be received from its Responses channel
+func NewFakeWatchRocketsStream(ctx context.Context) *FakeWatchRocketsStream {
+	return &FakeWatchRocketsStream{
+		FakeServerStream: FakeServerStream{Ctx: ctx},
+		Responses:        make(chan *rocketpb.WatchRocketsResponse, 16),
+	}
+}
+
+func (s *FakeWatchRocketsStream) Send(resp *rocketpb.WatchRocketsResponse) error {
+	select {
+	case s.Responses <- resp:
+		return nil
+	case <-s.Ctx.Done():
+		return s.Ctx.Err()
+	}
+}
+
+// FakeLogsStream records the responses sent by the Logs service
+type FakeLogsStream struct {
+	FakeServerStream
+	Responses chan *rocketpb.LogsResponse
+}
+
+// NewFakeLogsStream returns a stream whose responses can be received from its Responses channel
+func NewFakeLogsStream(ctx context.Context) *FakeLogsStream {
+	return &FakeLogsStream{
+		FakeServerStream: FakeServerStream{Ctx: ctx},
+		Responses:        make(chan *rocketpb.LogsResponse, 64),
+	}
+}
+
+func (s *FakeLogsStream) Send(resp *rocketpb.LogsResponse) error {
+	select {
+	case s.Responses <- resp:
+		return nil
+	case <-s.Ctx.Done():
+		return s.Ctx.Err()
+	}
+}
+
+// FakeWatchEventsStream records the responses sent by the WatchEvents service
+type FakeWatchEventsStream struct {
+	FakeServerStream
+	Responses chan *rocketpb.WatchEventsResponse
+}
+
+// NewFakeWatchEventsStream returns a stream whose responses can be received from its Responses channel
+func NewFakeWatchEventsStream(ctx context.Context) *FakeWatchEventsStream {
+	return &FakeWatchEventsStream{
+		FakeServerStream: FakeServerStream{Ctx: ctx},
+		Responses:        make(chan *rocketpb.WatchEventsResponse, 16),
+	}
+}
+
+func (s *FakeWatchEventsStream) Send(resp *rocketpb.WatchEventsResponse) error {
+	select {
+	case s.Responses <- resp:
+		return nil
+	case <-s.Ctx.Done():
+		return s.Ctx.Err()
+	}
+}
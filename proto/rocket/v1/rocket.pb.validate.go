@@ -0,0 +1,6090 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: rocket/v1/rocket.proto
+
+package rocket
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CreateRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateRequestMultiError, or
+// nil if none found.
+func (m *CreateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := CreateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CreateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CreateRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CreateRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRocketVersion() != "" {
+
+		if !_CreateRequest_RocketVersion_Pattern.MatchString(m.GetRocketVersion()) {
+			err := CreateRequestValidationError{
+				field:  "RocketVersion",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetMongodbVersion() != "" {
+
+		if !_CreateRequest_MongodbVersion_Pattern.MatchString(m.GetMongodbVersion()) {
+			err := CreateRequestValidationError{
+				field:  "MongodbVersion",
+				reason: "value does not match regex pattern \"^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetDatabaseSize(); val < 1 || val > 1024 {
+		err := CreateRequestValidationError{
+			field:  "DatabaseSize",
+			reason: "value must be inside range [1, 1024]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = CreateRequestValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUser()) > 56 {
+		err := CreateRequestValidationError{
+			field:  "User",
+			reason: "value length must be at most 56 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateRequest_User_Pattern.MatchString(m.GetUser()) {
+		err := CreateRequestValidationError{
+			field:  "User",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetReplicas(); val < 1 || val > 10 {
+		err := CreateRequestValidationError{
+			field:  "Replicas",
+			reason: "value must be inside range [1, 10]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateHostname(m.GetHost()); err != nil {
+		err = CreateRequestValidationError{
+			field:  "Host",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if m.GetIngressClass() != "" {
+
+		if utf8.RuneCountInString(m.GetIngressClass()) > 253 {
+			err := CreateRequestValidationError{
+				field:  "IngressClass",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CreateRequest_IngressClass_Pattern.MatchString(m.GetIngressClass()) {
+			err := CreateRequestValidationError{
+				field:  "IngressClass",
+				reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateRequestMultiError(errors)
+	}
+	return nil
+}
+
+func (m *CreateRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *CreateRequest) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// CreateRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateRequestMultiError) AllErrors() []error { return m }
+
+// CreateRequestValidationError is the validation error returned by
+// CreateRequest.Validate if the designated constraints aren't met.
+type CreateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateRequestValidationError) ErrorName() string { return "CreateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateRequestValidationError{}
+
+var _CreateRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _CreateRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _CreateRequest_RocketVersion_Pattern = regexp.MustCompile("^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$")
+
+var _CreateRequest_MongodbVersion_Pattern = regexp.MustCompile("^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$")
+
+var _CreateRequest_User_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _CreateRequest_IngressClass_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$")
+
+// Validate checks the field values on CreateResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CreateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CreateResponseMultiError,
+// or nil if none found.
+func (m *CreateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRocket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRocket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateResponseValidationError{
+				field:  "Rocket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CreateResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateResponseMultiError) AllErrors() []error { return m }
+
+// CreateResponseValidationError is the validation error returned by
+// CreateResponse.Validate if the designated constraints aren't met.
+type CreateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateResponseValidationError) ErrorName() string { return "CreateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CreateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateResponseValidationError{}
+
+// Validate checks the field values on GetRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetRequestMultiError, or
+// nil if none found.
+func (m *GetRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := GetRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := GetRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := GetRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := GetRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetRequestMultiError is an error wrapping multiple validation errors
+// returned by GetRequest.ValidateAll() if the designated constraints aren't met.
+type GetRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetRequestMultiError) AllErrors() []error { return m }
+
+// GetRequestValidationError is the validation error returned by
+// GetRequest.Validate if the designated constraints aren't met.
+type GetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetRequestValidationError) ErrorName() string { return "GetRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetRequestValidationError{}
+
+var _GetRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _GetRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on GetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetResponseMultiError, or
+// nil if none found.
+func (m *GetResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Phase
+
+	// no validation rules for WebserverVersion
+
+	// no validation rules for MongodbVersion
+
+	// no validation rules for DatabaseSize
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetResponseValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetResponseValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Host
+
+	// no validation rules for Replicas
+
+	// no validation rules for IngressClass
+
+	if len(errors) > 0 {
+		return GetResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetResponseMultiError is an error wrapping multiple validation errors
+// returned by GetResponse.ValidateAll() if the designated constraints aren't met.
+type GetResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResponseMultiError) AllErrors() []error { return m }
+
+// GetResponseValidationError is the validation error returned by
+// GetResponse.Validate if the designated constraints aren't met.
+type GetResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResponseValidationError) ErrorName() string { return "GetResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResponseValidationError{}
+
+// Validate checks the field values on ObjectMeta with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ObjectMeta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ObjectMeta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ObjectMetaMultiError, or
+// nil if none found.
+func (m *ObjectMeta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ObjectMeta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for ResourceVersion
+
+	// no validation rules for Generation
+
+	if all {
+		switch v := interface{}(m.GetCreationTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ObjectMetaValidationError{
+					field:  "CreationTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ObjectMetaValidationError{
+					field:  "CreationTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreationTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ObjectMetaValidationError{
+				field:  "CreationTimestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeletionTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ObjectMetaValidationError{
+					field:  "DeletionTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ObjectMetaValidationError{
+					field:  "DeletionTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeletionTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ObjectMetaValidationError{
+				field:  "DeletionTimestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Labels
+
+	// no validation rules for Annotations
+
+	if len(errors) > 0 {
+		return ObjectMetaMultiError(errors)
+	}
+	return nil
+}
+
+// ObjectMetaMultiError is an error wrapping multiple validation errors
+// returned by ObjectMeta.ValidateAll() if the designated constraints aren't met.
+type ObjectMetaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ObjectMetaMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ObjectMetaMultiError) AllErrors() []error { return m }
+
+// ObjectMetaValidationError is the validation error returned by
+// ObjectMeta.Validate if the designated constraints aren't met.
+type ObjectMetaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ObjectMetaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ObjectMetaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ObjectMetaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ObjectMetaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ObjectMetaValidationError) ErrorName() string { return "ObjectMetaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ObjectMetaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sObjectMeta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ObjectMetaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ObjectMetaValidationError{}
+
+// Validate checks the field values on GetAllRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetAllRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetAllRequestMultiError, or
+// nil if none found.
+func (m *GetAllRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetNamespace() != "" {
+
+		if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+			err := GetAllRequestValidationError{
+				field:  "Namespace",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_GetAllRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+			err := GetAllRequestValidationError{
+				field:  "Namespace",
+				reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetPageSize(); val < 0 || val > 500 {
+		err := GetAllRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be inside range [0, 500]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for FieldSelector
+
+	if _, ok := GetAllRequest_OrderBy_name[int32(m.GetOrderBy())]; !ok {
+		err := GetAllRequestValidationError{
+			field:  "OrderBy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetAllRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetAllRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAllRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAllRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllRequestMultiError) AllErrors() []error { return m }
+
+// GetAllRequestValidationError is the validation error returned by
+// GetAllRequest.Validate if the designated constraints aren't met.
+type GetAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllRequestValidationError) ErrorName() string { return "GetAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllRequestValidationError{}
+
+var _GetAllRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on GetAllResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetAllResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAllResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetAllResponseMultiError,
+// or nil if none found.
+func (m *GetAllResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAllResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRockets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAllResponseValidationError{
+						field:  fmt.Sprintf("Rockets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAllResponseValidationError{
+						field:  fmt.Sprintf("Rockets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAllResponseValidationError{
+					field:  fmt.Sprintf("Rockets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	// no validation rules for TotalSize
+
+	if len(errors) > 0 {
+		return GetAllResponseMultiError(errors)
+	}
+	return nil
+}
+
+// GetAllResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAllResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAllResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAllResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAllResponseMultiError) AllErrors() []error { return m }
+
+// GetAllResponseValidationError is the validation error returned by
+// GetAllResponse.Validate if the designated constraints aren't met.
+type GetAllResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAllResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAllResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAllResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAllResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAllResponseValidationError) ErrorName() string { return "GetAllResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAllResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAllResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetAllResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAllResponseValidationError{}
+
+// Validate checks the field values on UpdateRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateRequestMultiError, or
+// nil if none found.
+func (m *UpdateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUpdatedRocket() == nil {
+		err := UpdateRequestValidationError{
+			field:  "UpdatedRocket",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// skipping validation for updated_rocket
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return UpdateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// UpdateRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRequestValidationError is the validation error returned by
+// UpdateRequest.Validate if the designated constraints aren't met.
+type UpdateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRequestValidationError) ErrorName() string { return "UpdateRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRequestValidationError{}
+
+// Validate checks the field values on UpdateResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UpdateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UpdateResponseMultiError,
+// or nil if none found.
+func (m *UpdateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Successful
+
+	if all {
+		switch v := interface{}(m.GetRocket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRocket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateResponseValidationError{
+				field:  "Rocket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// UpdateResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateResponseMultiError) AllErrors() []error { return m }
+
+// UpdateResponseValidationError is the validation error returned by
+// UpdateResponse.Validate if the designated constraints aren't met.
+type UpdateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateResponseValidationError) ErrorName() string { return "UpdateResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UpdateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateResponseValidationError{}
+
+// Validate checks the field values on DeleteRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteRequestMultiError, or
+// nil if none found.
+func (m *DeleteRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := DeleteRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := DeleteRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := DeleteRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_DeleteRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := DeleteRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return DeleteRequestMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteRequestMultiError) AllErrors() []error { return m }
+
+// DeleteRequestValidationError is the validation error returned by
+// DeleteRequest.Validate if the designated constraints aren't met.
+type DeleteRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRequestValidationError) ErrorName() string { return "DeleteRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRequestValidationError{}
+
+var _DeleteRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _DeleteRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on DeleteResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeleteResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeleteResponseMultiError,
+// or nil if none found.
+func (m *DeleteResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRocket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeleteResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeleteResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRocket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeleteResponseValidationError{
+				field:  "Rocket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeleteResponseMultiError(errors)
+	}
+	return nil
+}
+
+// DeleteResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteResponseMultiError) AllErrors() []error { return m }
+
+// DeleteResponseValidationError is the validation error returned by
+// DeleteResponse.Validate if the designated constraints aren't met.
+type DeleteResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteResponseValidationError) ErrorName() string { return "DeleteResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeleteResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteResponseValidationError{}
+
+// Validate checks the field values on LogsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogsRequestMultiError, or
+// nil if none found.
+func (m *LogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := LogsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_LogsRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := LogsRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := LogsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_LogsRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := LogsRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPod() != "" {
+
+		if utf8.RuneCountInString(m.GetPod()) > 253 {
+			err := LogsRequestValidationError{
+				field:  "Pod",
+				reason: "value length must be at most 253 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_LogsRequest_Pod_Pattern.MatchString(m.GetPod()) {
+			err := LogsRequestValidationError{
+				field:  "Pod",
+				reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if _, ok := LogsRequest_Component_name[int32(m.GetComponent())]; !ok {
+		err := LogsRequestValidationError{
+			field:  "Component",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetContainer() != "" {
+
+		if utf8.RuneCountInString(m.GetContainer()) > 63 {
+			err := LogsRequestValidationError{
+				field:  "Container",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_LogsRequest_Container_Pattern.MatchString(m.GetContainer()) {
+			err := LogsRequestValidationError{
+				field:  "Container",
+				reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for Follow
+
+	// no validation rules for Previous
+
+	// no validation rules for Timestamps
+
+	if m.GetTailLines() < 0 {
+		err := LogsRequestValidationError{
+			field:  "TailLines",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := LogLevel_name[int32(m.GetMinLevel())]; !ok {
+		err := LogsRequestValidationError{
+			field:  "MinLevel",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFilter()) > 1024 {
+		err := LogsRequestValidationError{
+			field:  "Filter",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FilterRegex
+
+	switch m.Since.(type) {
+
+	case *LogsRequest_SinceSeconds:
+
+		if m.GetSinceSeconds() <= 0 {
+			err := LogsRequestValidationError{
+				field:  "SinceSeconds",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *LogsRequest_SinceTime:
+
+		if all {
+			switch v := interface{}(m.GetSinceTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogsRequestValidationError{
+						field:  "SinceTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogsRequestValidationError{
+						field:  "SinceTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSinceTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogsRequestValidationError{
+					field:  "SinceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LogsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// LogsRequestMultiError is an error wrapping multiple validation errors
+// returned by LogsRequest.ValidateAll() if the designated constraints aren't met.
+type LogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogsRequestMultiError) AllErrors() []error { return m }
+
+// LogsRequestValidationError is the validation error returned by
+// LogsRequest.Validate if the designated constraints aren't met.
+type LogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogsRequestValidationError) ErrorName() string { return "LogsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogsRequestValidationError{}
+
+var _LogsRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _LogsRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _LogsRequest_Pod_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _LogsRequest_Container_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on LogsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogsResponseMultiError, or
+// nil if none found.
+func (m *LogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Level
+
+	// no validation rules for Message
+
+	// no validation rules for Pod
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LogsResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LogsResponseValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogsResponseValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFields()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LogsResponseValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LogsResponseValidationError{
+					field:  "Fields",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFields()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LogsResponseValidationError{
+				field:  "Fields",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Event
+
+	if len(errors) > 0 {
+		return LogsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// LogsResponseMultiError is an error wrapping multiple validation errors
+// returned by LogsResponse.ValidateAll() if the designated constraints aren't met.
+type LogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogsResponseMultiError) AllErrors() []error { return m }
+
+// LogsResponseValidationError is the validation error returned by
+// LogsResponse.Validate if the designated constraints aren't met.
+type LogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogsResponseValidationError) ErrorName() string { return "LogsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogsResponseValidationError{}
+
+// Validate checks the field values on LogsArchiveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogsArchiveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogsArchiveRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogsArchiveRequestMultiError, or nil if none found.
+func (m *LogsArchiveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogsArchiveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := LogsArchiveRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_LogsArchiveRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := LogsArchiveRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := LogsArchiveRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_LogsArchiveRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := LogsArchiveRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch m.Since.(type) {
+
+	case *LogsArchiveRequest_SinceSeconds:
+
+		if m.GetSinceSeconds() <= 0 {
+			err := LogsArchiveRequestValidationError{
+				field:  "SinceSeconds",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *LogsArchiveRequest_SinceTime:
+
+		if all {
+			switch v := interface{}(m.GetSinceTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LogsArchiveRequestValidationError{
+						field:  "SinceTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LogsArchiveRequestValidationError{
+						field:  "SinceTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSinceTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LogsArchiveRequestValidationError{
+					field:  "SinceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return LogsArchiveRequestMultiError(errors)
+	}
+	return nil
+}
+
+// LogsArchiveRequestMultiError is an error wrapping multiple validation errors
+// returned by LogsArchiveRequest.ValidateAll() if the designated constraints
+// aren't met.
+type LogsArchiveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogsArchiveRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogsArchiveRequestMultiError) AllErrors() []error { return m }
+
+// LogsArchiveRequestValidationError is the validation error returned by
+// LogsArchiveRequest.Validate if the designated constraints aren't met.
+type LogsArchiveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogsArchiveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogsArchiveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogsArchiveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogsArchiveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogsArchiveRequestValidationError) ErrorName() string {
+	return "LogsArchiveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LogsArchiveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogsArchiveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogsArchiveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogsArchiveRequestValidationError{}
+
+var _LogsArchiveRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _LogsArchiveRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on LogsArchiveResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LogsArchiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogsArchiveResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogsArchiveResponseMultiError, or nil if none found.
+func (m *LogsArchiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogsArchiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archive
+
+	// no validation rules for Filename
+
+	if len(errors) > 0 {
+		return LogsArchiveResponseMultiError(errors)
+	}
+	return nil
+}
+
+// LogsArchiveResponseMultiError is an error wrapping multiple validation
+// errors returned by LogsArchiveResponse.ValidateAll() if the designated
+// constraints aren't met.
+type LogsArchiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogsArchiveResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogsArchiveResponseMultiError) AllErrors() []error { return m }
+
+// LogsArchiveResponseValidationError is the validation error returned by
+// LogsArchiveResponse.Validate if the designated constraints aren't met.
+type LogsArchiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogsArchiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogsArchiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogsArchiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogsArchiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogsArchiveResponseValidationError) ErrorName() string {
+	return "LogsArchiveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LogsArchiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogsArchiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogsArchiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogsArchiveResponseValidationError{}
+
+// Validate checks the field values on EventsRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventsRequestMultiError, or
+// nil if none found.
+func (m *EventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := EventsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_EventsRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := EventsRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := EventsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_EventsRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := EventsRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return EventsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// EventsRequestMultiError is an error wrapping multiple validation errors
+// returned by EventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type EventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventsRequestMultiError) AllErrors() []error { return m }
+
+// EventsRequestValidationError is the validation error returned by
+// EventsRequest.Validate if the designated constraints aren't met.
+type EventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventsRequestValidationError) ErrorName() string { return "EventsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventsRequestValidationError{}
+
+var _EventsRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _EventsRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on EventsResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventsResponseMultiError,
+// or nil if none found.
+func (m *EventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EventsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// EventsResponseMultiError is an error wrapping multiple validation errors
+// returned by EventsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type EventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventsResponseMultiError) AllErrors() []error { return m }
+
+// EventsResponseValidationError is the validation error returned by
+// EventsResponse.Validate if the designated constraints aren't met.
+type EventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventsResponseValidationError) ErrorName() string { return "EventsResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventsResponseValidationError{}
+
+// Validate checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchEventsRequestMultiError, or nil if none found.
+func (m *WatchEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := WatchEventsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchEventsRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := WatchEventsRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := WatchEventsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchEventsRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := WatchEventsRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WatchEventsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// WatchEventsRequestMultiError is an error wrapping multiple validation errors
+// returned by WatchEventsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WatchEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchEventsRequestMultiError) AllErrors() []error { return m }
+
+// WatchEventsRequestValidationError is the validation error returned by
+// WatchEventsRequest.Validate if the designated constraints aren't met.
+type WatchEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchEventsRequestValidationError) ErrorName() string {
+	return "WatchEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchEventsRequestValidationError{}
+
+var _WatchEventsRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _WatchEventsRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on WatchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchEventsResponseMultiError, or nil if none found.
+func (m *WatchEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEvent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchEventsResponseValidationError{
+					field:  "Event",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchEventsResponseValidationError{
+				field:  "Event",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WatchEventsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// WatchEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchEventsResponseMultiError) AllErrors() []error { return m }
+
+// WatchEventsResponseValidationError is the validation error returned by
+// WatchEventsResponse.Validate if the designated constraints aren't met.
+type WatchEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchEventsResponseValidationError) ErrorName() string {
+	return "WatchEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchEventsResponseValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for ObjectKind
+
+	// no validation rules for ObjectName
+
+	// no validation rules for Count
+
+	if all {
+		switch v := interface{}(m.GetFirstTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "FirstTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "FirstTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "FirstTimestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "LastTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "LastTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "LastTimestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on Issuer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Issuer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Issuer with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in IssuerMultiError, or nil if none found.
+func (m *Issuer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Issuer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for Ready
+
+	// no validation rules for Message
+
+	switch m.Mode.(type) {
+
+	case *Issuer_Acme:
+
+		if all {
+			switch v := interface{}(m.GetAcme()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "Acme",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "Acme",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAcme()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssuerValidationError{
+					field:  "Acme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Issuer_SelfSigned:
+
+		if all {
+			switch v := interface{}(m.GetSelfSigned()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "SelfSigned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "SelfSigned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSelfSigned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssuerValidationError{
+					field:  "SelfSigned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *Issuer_Ca:
+
+		if all {
+			switch v := interface{}(m.GetCa()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "Ca",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, IssuerValidationError{
+						field:  "Ca",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCa()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return IssuerValidationError{
+					field:  "Ca",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IssuerMultiError(errors)
+	}
+	return nil
+}
+
+// IssuerMultiError is an error wrapping multiple validation errors returned by
+// Issuer.ValidateAll() if the designated constraints aren't met.
+type IssuerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssuerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssuerMultiError) AllErrors() []error { return m }
+
+// IssuerValidationError is the validation error returned by Issuer.Validate if
+// the designated constraints aren't met.
+type IssuerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssuerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssuerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssuerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssuerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssuerValidationError) ErrorName() string { return "IssuerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssuerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssuer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssuerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssuerValidationError{}
+
+// Validate checks the field values on AcmeIssuer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AcmeIssuer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcmeIssuer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AcmeIssuerMultiError, or
+// nil if none found.
+func (m *AcmeIssuer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcmeIssuer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetServer()); err != nil {
+		err = AcmeIssuerValidationError{
+			field:  "Server",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := AcmeIssuerValidationError{
+			field:  "Server",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if err := m._validateEmail(m.GetEmail()); err != nil {
+		err = AcmeIssuerValidationError{
+			field:  "Email",
+			reason: "value must be a valid email address",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetIngressClass()) > 63 {
+		err := AcmeIssuerValidationError{
+			field:  "IngressClass",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_AcmeIssuer_IngressClass_Pattern.MatchString(m.GetIngressClass()) {
+		err := AcmeIssuerValidationError{
+			field:  "IngressClass",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AcmeIssuerMultiError(errors)
+	}
+	return nil
+}
+
+func (m *AcmeIssuer) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *AcmeIssuer) _validateEmail(addr string) error {
+	a, err := mail.ParseAddress(addr)
+	if err != nil {
+		return err
+	}
+	addr = a.Address
+
+	if len(addr) > 254 {
+		return errors.New("email addresses cannot exceed 254 characters")
+	}
+
+	parts := strings.SplitN(addr, "@", 2)
+
+	if len(parts[0]) > 64 {
+		return errors.New("email address local phrase cannot exceed 64 characters")
+	}
+
+	return m._validateHostname(parts[1])
+}
+
+// AcmeIssuerMultiError is an error wrapping multiple validation errors
+// returned by AcmeIssuer.ValidateAll() if the designated constraints aren't met.
+type AcmeIssuerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcmeIssuerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcmeIssuerMultiError) AllErrors() []error { return m }
+
+// AcmeIssuerValidationError is the validation error returned by
+// AcmeIssuer.Validate if the designated constraints aren't met.
+type AcmeIssuerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcmeIssuerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcmeIssuerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcmeIssuerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcmeIssuerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcmeIssuerValidationError) ErrorName() string { return "AcmeIssuerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AcmeIssuerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcmeIssuer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcmeIssuerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcmeIssuerValidationError{}
+
+var _AcmeIssuer_IngressClass_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on SelfSignedIssuer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SelfSignedIssuer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SelfSignedIssuer with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SelfSignedIssuerMultiError, or nil if none found.
+func (m *SelfSignedIssuer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SelfSignedIssuer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SelfSignedIssuerMultiError(errors)
+	}
+	return nil
+}
+
+// SelfSignedIssuerMultiError is an error wrapping multiple validation errors
+// returned by SelfSignedIssuer.ValidateAll() if the designated constraints
+// aren't met.
+type SelfSignedIssuerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SelfSignedIssuerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SelfSignedIssuerMultiError) AllErrors() []error { return m }
+
+// SelfSignedIssuerValidationError is the validation error returned by
+// SelfSignedIssuer.Validate if the designated constraints aren't met.
+type SelfSignedIssuerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SelfSignedIssuerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SelfSignedIssuerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SelfSignedIssuerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SelfSignedIssuerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SelfSignedIssuerValidationError) ErrorName() string { return "SelfSignedIssuerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SelfSignedIssuerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSelfSignedIssuer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SelfSignedIssuerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SelfSignedIssuerValidationError{}
+
+// Validate checks the field values on CaIssuer with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CaIssuer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaIssuer with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CaIssuerMultiError, or nil
+// if none found.
+func (m *CaIssuer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaIssuer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetSecretName()) > 253 {
+		err := CaIssuerValidationError{
+			field:  "SecretName",
+			reason: "value length must be at most 253 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CaIssuer_SecretName_Pattern.MatchString(m.GetSecretName()) {
+		err := CaIssuerValidationError{
+			field:  "SecretName",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CaIssuerMultiError(errors)
+	}
+	return nil
+}
+
+// CaIssuerMultiError is an error wrapping multiple validation errors returned
+// by CaIssuer.ValidateAll() if the designated constraints aren't met.
+type CaIssuerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaIssuerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaIssuerMultiError) AllErrors() []error { return m }
+
+// CaIssuerValidationError is the validation error returned by
+// CaIssuer.Validate if the designated constraints aren't met.
+type CaIssuerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaIssuerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaIssuerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaIssuerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaIssuerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaIssuerValidationError) ErrorName() string { return "CaIssuerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CaIssuerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaIssuer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaIssuerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaIssuerValidationError{}
+
+var _CaIssuer_SecretName_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$")
+
+// Validate checks the field values on GetIssuerRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetIssuerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetIssuerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetIssuerRequestMultiError, or nil if none found.
+func (m *GetIssuerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetIssuerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := GetIssuerRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetIssuerRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := GetIssuerRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUser()) > 56 {
+		err := GetIssuerRequestValidationError{
+			field:  "User",
+			reason: "value length must be at most 56 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_GetIssuerRequest_User_Pattern.MatchString(m.GetUser()) {
+		err := GetIssuerRequestValidationError{
+			field:  "User",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetIssuerRequestMultiError(errors)
+	}
+	return nil
+}
+
+// GetIssuerRequestMultiError is an error wrapping multiple validation errors
+// returned by GetIssuerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetIssuerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetIssuerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetIssuerRequestMultiError) AllErrors() []error { return m }
+
+// GetIssuerRequestValidationError is the validation error returned by
+// GetIssuerRequest.Validate if the designated constraints aren't met.
+type GetIssuerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetIssuerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetIssuerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetIssuerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetIssuerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetIssuerRequestValidationError) ErrorName() string { return "GetIssuerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetIssuerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetIssuerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetIssuerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetIssuerRequestValidationError{}
+
+var _GetIssuerRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _GetIssuerRequest_User_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on UpdateIssuerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateIssuerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateIssuerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateIssuerRequestMultiError, or nil if none found.
+func (m *UpdateIssuerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateIssuerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := UpdateIssuerRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateIssuerRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := UpdateIssuerRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUser()) > 56 {
+		err := UpdateIssuerRequestValidationError{
+			field:  "User",
+			reason: "value length must be at most 56 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_UpdateIssuerRequest_User_Pattern.MatchString(m.GetUser()) {
+		err := UpdateIssuerRequestValidationError{
+			field:  "User",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch m.Mode.(type) {
+
+	case *UpdateIssuerRequest_Acme:
+
+		if all {
+			switch v := interface{}(m.GetAcme()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "Acme",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "Acme",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAcme()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateIssuerRequestValidationError{
+					field:  "Acme",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateIssuerRequest_SelfSigned:
+
+		if all {
+			switch v := interface{}(m.GetSelfSigned()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "SelfSigned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "SelfSigned",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSelfSigned()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateIssuerRequestValidationError{
+					field:  "SelfSigned",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *UpdateIssuerRequest_Ca:
+
+		if all {
+			switch v := interface{}(m.GetCa()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "Ca",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateIssuerRequestValidationError{
+						field:  "Ca",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCa()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateIssuerRequestValidationError{
+					field:  "Ca",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		err := UpdateIssuerRequestValidationError{
+			field:  "Mode",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateIssuerRequestMultiError(errors)
+	}
+	return nil
+}
+
+// UpdateIssuerRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateIssuerRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateIssuerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateIssuerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateIssuerRequestMultiError) AllErrors() []error { return m }
+
+// UpdateIssuerRequestValidationError is the validation error returned by
+// UpdateIssuerRequest.Validate if the designated constraints aren't met.
+type UpdateIssuerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateIssuerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateIssuerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateIssuerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateIssuerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateIssuerRequestValidationError) ErrorName() string {
+	return "UpdateIssuerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateIssuerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateIssuerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateIssuerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateIssuerRequestValidationError{}
+
+var _UpdateIssuerRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _UpdateIssuerRequest_User_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on CertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CertificateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CertificateRequestMultiError, or nil if none found.
+func (m *CertificateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CertificateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := CertificateRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CertificateRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := CertificateRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CertificateRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CertificateRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CertificateRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CertificateRequestMultiError(errors)
+	}
+	return nil
+}
+
+// CertificateRequestMultiError is an error wrapping multiple validation errors
+// returned by CertificateRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateRequestMultiError) AllErrors() []error { return m }
+
+// CertificateRequestValidationError is the validation error returned by
+// CertificateRequest.Validate if the designated constraints aren't met.
+type CertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateRequestValidationError) ErrorName() string {
+	return "CertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateRequestValidationError{}
+
+var _CertificateRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _CertificateRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on CertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CertificateResponseMultiError, or nil if none found.
+func (m *CertificateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CertificateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for SecretName
+
+	// no validation rules for Issuer
+
+	// no validation rules for Ready
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetNotBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateResponseValidationError{
+				field:  "NotBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNotAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateResponseValidationError{
+				field:  "NotAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRenewalTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "RenewalTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "RenewalTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRenewalTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateResponseValidationError{
+				field:  "RenewalTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for FailureReason
+
+	if all {
+		switch v := interface{}(m.GetLastFailureTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "LastFailureTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateResponseValidationError{
+					field:  "LastFailureTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastFailureTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateResponseValidationError{
+				field:  "LastFailureTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CertificateResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CertificateResponseMultiError is an error wrapping multiple validation
+// errors returned by CertificateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CertificateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateResponseMultiError) AllErrors() []error { return m }
+
+// CertificateResponseValidationError is the validation error returned by
+// CertificateResponse.Validate if the designated constraints aren't met.
+type CertificateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateResponseValidationError) ErrorName() string {
+	return "CertificateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CertificateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateResponseValidationError{}
+
+// Validate checks the field values on ListIngressClassesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIngressClassesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIngressClassesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIngressClassesRequestMultiError, or nil if none found.
+func (m *ListIngressClassesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIngressClassesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListIngressClassesRequestMultiError(errors)
+	}
+	return nil
+}
+
+// ListIngressClassesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListIngressClassesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListIngressClassesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIngressClassesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIngressClassesRequestMultiError) AllErrors() []error { return m }
+
+// ListIngressClassesRequestValidationError is the validation error returned by
+// ListIngressClassesRequest.Validate if the designated constraints aren't met.
+type ListIngressClassesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIngressClassesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIngressClassesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIngressClassesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIngressClassesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIngressClassesRequestValidationError) ErrorName() string {
+	return "ListIngressClassesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIngressClassesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIngressClassesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIngressClassesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIngressClassesRequestValidationError{}
+
+// Validate checks the field values on ListIngressClassesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIngressClassesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIngressClassesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListIngressClassesResponseMultiError, or nil if none found.
+func (m *ListIngressClassesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIngressClassesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetIngressClasses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIngressClassesResponseValidationError{
+						field:  fmt.Sprintf("IngressClasses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIngressClassesResponseValidationError{
+						field:  fmt.Sprintf("IngressClasses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIngressClassesResponseValidationError{
+					field:  fmt.Sprintf("IngressClasses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIngressClassesResponseMultiError(errors)
+	}
+	return nil
+}
+
+// ListIngressClassesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListIngressClassesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListIngressClassesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIngressClassesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIngressClassesResponseMultiError) AllErrors() []error { return m }
+
+// ListIngressClassesResponseValidationError is the validation error returned
+// by ListIngressClassesResponse.Validate if the designated constraints aren't met.
+type ListIngressClassesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIngressClassesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIngressClassesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIngressClassesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIngressClassesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIngressClassesResponseValidationError) ErrorName() string {
+	return "ListIngressClassesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIngressClassesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIngressClassesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListIngressClassesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIngressClassesResponseValidationError{}
+
+// Validate checks the field values on IngressClass with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IngressClass) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IngressClass with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IngressClassMultiError, or
+// nil if none found.
+func (m *IngressClass) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IngressClass) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Controller
+
+	// no validation rules for IsDefault
+
+	if len(errors) > 0 {
+		return IngressClassMultiError(errors)
+	}
+	return nil
+}
+
+// IngressClassMultiError is an error wrapping multiple validation errors
+// returned by IngressClass.ValidateAll() if the designated constraints aren't met.
+type IngressClassMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IngressClassMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IngressClassMultiError) AllErrors() []error { return m }
+
+// IngressClassValidationError is the validation error returned by
+// IngressClass.Validate if the designated constraints aren't met.
+type IngressClassValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IngressClassValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IngressClassValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IngressClassValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IngressClassValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IngressClassValidationError) ErrorName() string { return "IngressClassValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IngressClassValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIngressClass.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IngressClassValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IngressClassValidationError{}
+
+// Validate checks the field values on CheckHostAvailabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckHostAvailabilityRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckHostAvailabilityRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckHostAvailabilityRequestMultiError, or nil if none found.
+func (m *CheckHostAvailabilityRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckHostAvailabilityRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateHostname(m.GetHost()); err != nil {
+		err = CheckHostAvailabilityRequestValidationError{
+			field:  "Host",
+			reason: "value must be a valid hostname",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := CheckHostAvailabilityRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CheckHostAvailabilityRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := CheckHostAvailabilityRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetName() != "" {
+
+		if utf8.RuneCountInString(m.GetName()) > 63 {
+			err := CheckHostAvailabilityRequestValidationError{
+				field:  "Name",
+				reason: "value length must be at most 63 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if !_CheckHostAvailabilityRequest_Name_Pattern.MatchString(m.GetName()) {
+			err := CheckHostAvailabilityRequestValidationError{
+				field:  "Name",
+				reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckHostAvailabilityRequestMultiError(errors)
+	}
+	return nil
+}
+
+func (m *CheckHostAvailabilityRequest) _validateHostname(host string) error {
+	s := strings.ToLower(strings.TrimSuffix(host, "."))
+
+	if len(host) > 253 {
+		return errors.New("hostname cannot exceed 253 characters")
+	}
+
+	for _, part := range strings.Split(s, ".") {
+		if l := len(part); l == 0 || l > 63 {
+			return errors.New("hostname part must be non-empty and cannot exceed 63 characters")
+		}
+
+		if part[0] == '-' {
+			return errors.New("hostname parts cannot begin with hyphens")
+		}
+
+		if part[len(part)-1] == '-' {
+			return errors.New("hostname parts cannot end with hyphens")
+		}
+
+		for _, r := range part {
+			if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+				return fmt.Errorf("hostname parts can only contain alphanumeric characters or hyphens, got %q", string(r))
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckHostAvailabilityRequestMultiError is an error wrapping multiple
+// validation errors returned by CheckHostAvailabilityRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CheckHostAvailabilityRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckHostAvailabilityRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckHostAvailabilityRequestMultiError) AllErrors() []error { return m }
+
+// CheckHostAvailabilityRequestValidationError is the validation error returned
+// by CheckHostAvailabilityRequest.Validate if the designated constraints
+// aren't met.
+type CheckHostAvailabilityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckHostAvailabilityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckHostAvailabilityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckHostAvailabilityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckHostAvailabilityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckHostAvailabilityRequestValidationError) ErrorName() string {
+	return "CheckHostAvailabilityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckHostAvailabilityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckHostAvailabilityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckHostAvailabilityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckHostAvailabilityRequestValidationError{}
+
+var _CheckHostAvailabilityRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _CheckHostAvailabilityRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on CheckHostAvailabilityResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckHostAvailabilityResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckHostAvailabilityResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CheckHostAvailabilityResponseMultiError, or nil if none found.
+func (m *CheckHostAvailabilityResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckHostAvailabilityResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Available
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return CheckHostAvailabilityResponseMultiError(errors)
+	}
+	return nil
+}
+
+// CheckHostAvailabilityResponseMultiError is an error wrapping multiple
+// validation errors returned by CheckHostAvailabilityResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CheckHostAvailabilityResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckHostAvailabilityResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckHostAvailabilityResponseMultiError) AllErrors() []error { return m }
+
+// CheckHostAvailabilityResponseValidationError is the validation error
+// returned by CheckHostAvailabilityResponse.Validate if the designated
+// constraints aren't met.
+type CheckHostAvailabilityResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckHostAvailabilityResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckHostAvailabilityResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckHostAvailabilityResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckHostAvailabilityResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckHostAvailabilityResponseValidationError) ErrorName() string {
+	return "CheckHostAvailabilityResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckHostAvailabilityResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckHostAvailabilityResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckHostAvailabilityResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckHostAvailabilityResponseValidationError{}
+
+// Validate checks the field values on StatusRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusRequestMultiError, or
+// nil if none found.
+func (m *StatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 63 {
+		err := StatusRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StatusRequest_Name_Pattern.MatchString(m.GetName()) {
+		err := StatusRequestValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := StatusRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_StatusRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := StatusRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StatusRequestMultiError(errors)
+	}
+	return nil
+}
+
+// StatusRequestMultiError is an error wrapping multiple validation errors
+// returned by StatusRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusRequestMultiError) AllErrors() []error { return m }
+
+// StatusRequestValidationError is the validation error returned by
+// StatusRequest.Validate if the designated constraints aren't met.
+type StatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusRequestValidationError) ErrorName() string { return "StatusRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusRequestValidationError{}
+
+var _StatusRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+var _StatusRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on StatusResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatusResponseMultiError,
+// or nil if none found.
+func (m *StatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Ready
+
+	// no validation rules for Type
+
+	// no validation rules for Phase
+
+	// no validation rules for ResourceVersion
+
+	if len(errors) > 0 {
+		return StatusResponseMultiError(errors)
+	}
+	return nil
+}
+
+// StatusResponseMultiError is an error wrapping multiple validation errors
+// returned by StatusResponse.ValidateAll() if the designated constraints
+// aren't met.
+type StatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusResponseMultiError) AllErrors() []error { return m }
+
+// StatusResponseValidationError is the validation error returned by
+// StatusResponse.Validate if the designated constraints aren't met.
+type StatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusResponseValidationError) ErrorName() string { return "StatusResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusResponseValidationError{}
+
+// Validate checks the field values on WatchRocketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRocketsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRocketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRocketsRequestMultiError, or nil if none found.
+func (m *WatchRocketsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRocketsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNamespace()) > 63 {
+		err := WatchRocketsRequestValidationError{
+			field:  "Namespace",
+			reason: "value length must be at most 63 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_WatchRocketsRequest_Namespace_Pattern.MatchString(m.GetNamespace()) {
+		err := WatchRocketsRequestValidationError{
+			field:  "Namespace",
+			reason: "value does not match regex pattern \"^[a-z0-9]([-a-z0-9]*[a-z0-9])?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for LabelSelector
+
+	// no validation rules for ResourceVersion
+
+	if len(errors) > 0 {
+		return WatchRocketsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// WatchRocketsRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchRocketsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchRocketsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRocketsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRocketsRequestMultiError) AllErrors() []error { return m }
+
+// WatchRocketsRequestValidationError is the validation error returned by
+// WatchRocketsRequest.Validate if the designated constraints aren't met.
+type WatchRocketsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRocketsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRocketsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRocketsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRocketsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRocketsRequestValidationError) ErrorName() string {
+	return "WatchRocketsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRocketsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRocketsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRocketsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRocketsRequestValidationError{}
+
+var _WatchRocketsRequest_Namespace_Pattern = regexp.MustCompile("^[a-z0-9]([-a-z0-9]*[a-z0-9])?$")
+
+// Validate checks the field values on WatchRocketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchRocketsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchRocketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchRocketsResponseMultiError, or nil if none found.
+func (m *WatchRocketsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchRocketsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetRocket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchRocketsResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchRocketsResponseValidationError{
+					field:  "Rocket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRocket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchRocketsResponseValidationError{
+				field:  "Rocket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResourceVersion
+
+	if len(errors) > 0 {
+		return WatchRocketsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// WatchRocketsResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchRocketsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchRocketsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchRocketsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchRocketsResponseMultiError) AllErrors() []error { return m }
+
+// WatchRocketsResponseValidationError is the validation error returned by
+// WatchRocketsResponse.Validate if the designated constraints aren't met.
+type WatchRocketsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchRocketsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchRocketsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchRocketsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchRocketsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchRocketsResponseValidationError) ErrorName() string {
+	return "WatchRocketsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchRocketsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchRocketsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WatchRocketsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchRocketsResponseValidationError{}
+
+// Validate checks the field values on AvailableVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AvailableVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AvailableVersionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AvailableVersionsRequestMultiError, or nil if none found.
+func (m *AvailableVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AvailableVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _AvailableVersionsRequest_Image_NotInLookup[m.GetImage()]; ok {
+		err := AvailableVersionsRequestValidationError{
+			field:  "Image",
+			reason: "value must not be in list [0]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := AvailableVersionsRequest_Image_name[int32(m.GetImage())]; !ok {
+		err := AvailableVersionsRequestValidationError{
+			field:  "Image",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return AvailableVersionsRequestMultiError(errors)
+	}
+	return nil
+}
+
+// AvailableVersionsRequestMultiError is an error wrapping multiple validation
+// errors returned by AvailableVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AvailableVersionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AvailableVersionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AvailableVersionsRequestMultiError) AllErrors() []error { return m }
+
+// AvailableVersionsRequestValidationError is the validation error returned by
+// AvailableVersionsRequest.Validate if the designated constraints aren't met.
+type AvailableVersionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AvailableVersionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AvailableVersionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AvailableVersionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AvailableVersionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AvailableVersionsRequestValidationError) ErrorName() string {
+	return "AvailableVersionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AvailableVersionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAvailableVersionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AvailableVersionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AvailableVersionsRequestValidationError{}
+
+var _AvailableVersionsRequest_Image_NotInLookup = map[AvailableVersionsRequest_Image]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on AvailableVersionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AvailableVersionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AvailableVersionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AvailableVersionsResponseMultiError, or nil if none found.
+func (m *AvailableVersionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AvailableVersionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AvailableVersionsResponseMultiError(errors)
+	}
+	return nil
+}
+
+// AvailableVersionsResponseMultiError is an error wrapping multiple validation
+// errors returned by AvailableVersionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AvailableVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AvailableVersionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AvailableVersionsResponseMultiError) AllErrors() []error { return m }
+
+// AvailableVersionsResponseValidationError is the validation error returned by
+// AvailableVersionsResponse.Validate if the designated constraints aren't met.
+type AvailableVersionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AvailableVersionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AvailableVersionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AvailableVersionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AvailableVersionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AvailableVersionsResponseValidationError) ErrorName() string {
+	return "AvailableVersionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AvailableVersionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAvailableVersionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AvailableVersionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AvailableVersionsResponseValidationError{}
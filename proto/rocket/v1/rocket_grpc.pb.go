@@ -23,8 +23,17 @@ type RocketServiceClient interface {
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (RocketService_StatusClient, error)
+	WatchRockets(ctx context.Context, in *WatchRocketsRequest, opts ...grpc.CallOption) (RocketService_WatchRocketsClient, error)
 	GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error)
 	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (RocketService_LogsClient, error)
+	LogsArchive(ctx context.Context, in *LogsArchiveRequest, opts ...grpc.CallOption) (*LogsArchiveResponse, error)
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsResponse, error)
+	WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (RocketService_WatchEventsClient, error)
+	GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*Issuer, error)
+	UpdateIssuer(ctx context.Context, in *UpdateIssuerRequest, opts ...grpc.CallOption) (*Issuer, error)
+	Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	ListIngressClasses(ctx context.Context, in *ListIngressClassesRequest, opts ...grpc.CallOption) (*ListIngressClassesResponse, error)
+	CheckHostAvailability(ctx context.Context, in *CheckHostAvailabilityRequest, opts ...grpc.CallOption) (*CheckHostAvailabilityResponse, error)
 	AvailableVersions(ctx context.Context, in *AvailableVersionsRequest, opts ...grpc.CallOption) (*AvailableVersionsResponse, error)
 }
 
@@ -104,6 +113,38 @@ func (x *rocketServiceStatusClient) Recv() (*StatusResponse, error) {
 	return m, nil
 }
 
+func (c *rocketServiceClient) WatchRockets(ctx context.Context, in *WatchRocketsRequest, opts ...grpc.CallOption) (RocketService_WatchRocketsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RocketService_ServiceDesc.Streams[1], "/rocket.v1.RocketService/WatchRockets", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rocketServiceWatchRocketsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RocketService_WatchRocketsClient interface {
+	Recv() (*WatchRocketsResponse, error)
+	grpc.ClientStream
+}
+
+type rocketServiceWatchRocketsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rocketServiceWatchRocketsClient) Recv() (*WatchRocketsResponse, error) {
+	m := new(WatchRocketsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *rocketServiceClient) GetAll(ctx context.Context, in *GetAllRequest, opts ...grpc.CallOption) (*GetAllResponse, error) {
 	out := new(GetAllResponse)
 	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/GetAll", in, out, opts...)
@@ -114,7 +155,7 @@ func (c *rocketServiceClient) GetAll(ctx context.Context, in *GetAllRequest, opt
 }
 
 func (c *rocketServiceClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (RocketService_LogsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &RocketService_ServiceDesc.Streams[1], "/rocket.v1.RocketService/Logs", opts...)
+	stream, err := c.cc.NewStream(ctx, &RocketService_ServiceDesc.Streams[2], "/rocket.v1.RocketService/Logs", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +186,101 @@ func (x *rocketServiceLogsClient) Recv() (*LogsResponse, error) {
 	return m, nil
 }
 
+func (c *rocketServiceClient) LogsArchive(ctx context.Context, in *LogsArchiveRequest, opts ...grpc.CallOption) (*LogsArchiveResponse, error) {
+	out := new(LogsArchiveResponse)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/LogsArchive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (*EventsResponse, error) {
+	out := new(EventsResponse)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/Events", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) WatchEvents(ctx context.Context, in *WatchEventsRequest, opts ...grpc.CallOption) (RocketService_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RocketService_ServiceDesc.Streams[3], "/rocket.v1.RocketService/WatchEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &rocketServiceWatchEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RocketService_WatchEventsClient interface {
+	Recv() (*WatchEventsResponse, error)
+	grpc.ClientStream
+}
+
+type rocketServiceWatchEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *rocketServiceWatchEventsClient) Recv() (*WatchEventsResponse, error) {
+	m := new(WatchEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *rocketServiceClient) GetIssuer(ctx context.Context, in *GetIssuerRequest, opts ...grpc.CallOption) (*Issuer, error) {
+	out := new(Issuer)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/GetIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) UpdateIssuer(ctx context.Context, in *UpdateIssuerRequest, opts ...grpc.CallOption) (*Issuer, error) {
+	out := new(Issuer)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/UpdateIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error) {
+	out := new(CertificateResponse)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/Certificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) ListIngressClasses(ctx context.Context, in *ListIngressClassesRequest, opts ...grpc.CallOption) (*ListIngressClassesResponse, error) {
+	out := new(ListIngressClassesResponse)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/ListIngressClasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rocketServiceClient) CheckHostAvailability(ctx context.Context, in *CheckHostAvailabilityRequest, opts ...grpc.CallOption) (*CheckHostAvailabilityResponse, error) {
+	out := new(CheckHostAvailabilityResponse)
+	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/CheckHostAvailability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rocketServiceClient) AvailableVersions(ctx context.Context, in *AvailableVersionsRequest, opts ...grpc.CallOption) (*AvailableVersionsResponse, error) {
 	out := new(AvailableVersionsResponse)
 	err := c.cc.Invoke(ctx, "/rocket.v1.RocketService/AvailableVersions", in, out, opts...)
@@ -163,8 +299,17 @@ type RocketServiceServer interface {
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	Status(*StatusRequest, RocketService_StatusServer) error
+	WatchRockets(*WatchRocketsRequest, RocketService_WatchRocketsServer) error
 	GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error)
 	Logs(*LogsRequest, RocketService_LogsServer) error
+	LogsArchive(context.Context, *LogsArchiveRequest) (*LogsArchiveResponse, error)
+	Events(context.Context, *EventsRequest) (*EventsResponse, error)
+	WatchEvents(*WatchEventsRequest, RocketService_WatchEventsServer) error
+	GetIssuer(context.Context, *GetIssuerRequest) (*Issuer, error)
+	UpdateIssuer(context.Context, *UpdateIssuerRequest) (*Issuer, error)
+	Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	ListIngressClasses(context.Context, *ListIngressClassesRequest) (*ListIngressClassesResponse, error)
+	CheckHostAvailability(context.Context, *CheckHostAvailabilityRequest) (*CheckHostAvailabilityResponse, error)
 	AvailableVersions(context.Context, *AvailableVersionsRequest) (*AvailableVersionsResponse, error)
 }
 
@@ -187,12 +332,39 @@ func (UnimplementedRocketServiceServer) Get(context.Context, *GetRequest) (*GetR
 func (UnimplementedRocketServiceServer) Status(*StatusRequest, RocketService_StatusServer) error {
 	return status.Errorf(codes.Unimplemented, "method Status not implemented")
 }
+func (UnimplementedRocketServiceServer) WatchRockets(*WatchRocketsRequest, RocketService_WatchRocketsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchRockets not implemented")
+}
 func (UnimplementedRocketServiceServer) GetAll(context.Context, *GetAllRequest) (*GetAllResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
 func (UnimplementedRocketServiceServer) Logs(*LogsRequest, RocketService_LogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
 }
+func (UnimplementedRocketServiceServer) LogsArchive(context.Context, *LogsArchiveRequest) (*LogsArchiveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogsArchive not implemented")
+}
+func (UnimplementedRocketServiceServer) Events(context.Context, *EventsRequest) (*EventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedRocketServiceServer) WatchEvents(*WatchEventsRequest, RocketService_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (UnimplementedRocketServiceServer) GetIssuer(context.Context, *GetIssuerRequest) (*Issuer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIssuer not implemented")
+}
+func (UnimplementedRocketServiceServer) UpdateIssuer(context.Context, *UpdateIssuerRequest) (*Issuer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIssuer not implemented")
+}
+func (UnimplementedRocketServiceServer) Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Certificate not implemented")
+}
+func (UnimplementedRocketServiceServer) ListIngressClasses(context.Context, *ListIngressClassesRequest) (*ListIngressClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIngressClasses not implemented")
+}
+func (UnimplementedRocketServiceServer) CheckHostAvailability(context.Context, *CheckHostAvailabilityRequest) (*CheckHostAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckHostAvailability not implemented")
+}
 func (UnimplementedRocketServiceServer) AvailableVersions(context.Context, *AvailableVersionsRequest) (*AvailableVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AvailableVersions not implemented")
 }
@@ -301,6 +473,27 @@ func (x *rocketServiceStatusServer) Send(m *StatusResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RocketService_WatchRockets_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRocketsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RocketServiceServer).WatchRockets(m, &rocketServiceWatchRocketsServer{stream})
+}
+
+type RocketService_WatchRocketsServer interface {
+	Send(*WatchRocketsResponse) error
+	grpc.ServerStream
+}
+
+type rocketServiceWatchRocketsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rocketServiceWatchRocketsServer) Send(m *WatchRocketsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RocketService_GetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAllRequest)
 	if err := dec(in); err != nil {
@@ -340,6 +533,153 @@ func (x *rocketServiceLogsServer) Send(m *LogsResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _RocketService_LogsArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogsArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).LogsArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/LogsArchive",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).LogsArchive(ctx, req.(*LogsArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_Events_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).Events(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/Events",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).Events(ctx, req.(*EventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RocketServiceServer).WatchEvents(m, &rocketServiceWatchEventsServer{stream})
+}
+
+type RocketService_WatchEventsServer interface {
+	Send(*WatchEventsResponse) error
+	grpc.ServerStream
+}
+
+type rocketServiceWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *rocketServiceWatchEventsServer) Send(m *WatchEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _RocketService_GetIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).GetIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/GetIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).GetIssuer(ctx, req.(*GetIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_UpdateIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).UpdateIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/UpdateIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).UpdateIssuer(ctx, req.(*UpdateIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_Certificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).Certificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/Certificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).Certificate(ctx, req.(*CertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_ListIngressClasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIngressClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).ListIngressClasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/ListIngressClasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).ListIngressClasses(ctx, req.(*ListIngressClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RocketService_CheckHostAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckHostAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RocketServiceServer).CheckHostAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rocket.v1.RocketService/CheckHostAvailability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RocketServiceServer).CheckHostAvailability(ctx, req.(*CheckHostAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RocketService_AvailableVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AvailableVersionsRequest)
 	if err := dec(in); err != nil {
@@ -385,6 +725,34 @@ var RocketService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _RocketService_GetAll_Handler,
 		},
+		{
+			MethodName: "LogsArchive",
+			Handler:    _RocketService_LogsArchive_Handler,
+		},
+		{
+			MethodName: "Events",
+			Handler:    _RocketService_Events_Handler,
+		},
+		{
+			MethodName: "GetIssuer",
+			Handler:    _RocketService_GetIssuer_Handler,
+		},
+		{
+			MethodName: "UpdateIssuer",
+			Handler:    _RocketService_UpdateIssuer_Handler,
+		},
+		{
+			MethodName: "Certificate",
+			Handler:    _RocketService_Certificate_Handler,
+		},
+		{
+			MethodName: "ListIngressClasses",
+			Handler:    _RocketService_ListIngressClasses_Handler,
+		},
+		{
+			MethodName: "CheckHostAvailability",
+			Handler:    _RocketService_CheckHostAvailability_Handler,
+		},
 		{
 			MethodName: "AvailableVersions",
 			Handler:    _RocketService_AvailableVersions_Handler,
@@ -396,11 +764,21 @@ var RocketService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _RocketService_Status_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchRockets",
+			Handler:       _RocketService_WatchRockets_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Logs",
 			Handler:       _RocketService_Logs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _RocketService_WatchEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rocket/v1/rocket.proto",
 }